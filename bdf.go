@@ -0,0 +1,202 @@
+package scrollphathd
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadBDF parses a font in the Glyph Bitmap Distribution Format (BDF), as used by X11. Each
+// glyph keeps its own bounding box and advance width. Glyphs without a standard encoding are
+// skipped.
+// Use Smoothed or Downsampled on the result for antialiased variants of the font.
+func LoadBDF(r io.Reader) (*BitmapFont, error) {
+	p := &bdfParser{
+		scanner: bufio.NewScanner(r),
+		glyphs:  map[rune]Glyph{},
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return NewBitmapFont(p.glyphs, p.ascent+p.descent, p.ascent), nil
+}
+
+type bdfParser struct {
+	scanner *bufio.Scanner
+	line    int
+	glyphs  map[rune]Glyph
+
+	ascent, descent int
+	haveAscent      bool
+	haveDescent     bool
+}
+
+// next returns the fields of the next non-empty line, or nil at the end of the input.
+func (p *bdfParser) next() ([]string, error) {
+	for p.scanner.Scan() {
+		p.line++
+		if fields := strings.Fields(p.scanner.Text()); len(fields) > 0 {
+			return fields, nil
+		}
+	}
+	return nil, p.scanner.Err()
+}
+
+func (p *bdfParser) parse() error {
+	fields, err := p.next()
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 || fields[0] != "STARTFONT" {
+		return fmt.Errorf("received invalid BDF font: missing STARTFONT")
+	}
+
+	for {
+		fields, err := p.next()
+		if err != nil {
+			return err
+		}
+		if fields == nil {
+			return fmt.Errorf("received invalid BDF font: missing ENDFONT")
+		}
+
+		switch fields[0] {
+		case "FONTBOUNDINGBOX":
+			vals, err := p.ints(fields, 4)
+			if err != nil {
+				return err
+			}
+			// Only used if the font doesn't supply explicit ascent and descent properties
+			if !p.haveAscent {
+				p.ascent = vals[1] + vals[3]
+			}
+			if !p.haveDescent {
+				p.descent = -vals[3]
+			}
+		case "FONT_ASCENT", "FONT_DESCENT":
+			vals, err := p.ints(fields, 1)
+			if err != nil {
+				return err
+			}
+			if fields[0] == "FONT_ASCENT" {
+				p.ascent, p.haveAscent = vals[0], true
+			} else {
+				p.descent, p.haveDescent = vals[0], true
+			}
+		case "STARTCHAR":
+			if err := p.parseChar(); err != nil {
+				return err
+			}
+		case "ENDFONT":
+			return nil
+		}
+	}
+}
+
+// parseChar parses a single glyph, up to and including its ENDCHAR line.
+func (p *bdfParser) parseChar() error {
+	encoding := -1
+	advance := 0
+	var width, height, offsetX, offsetY int
+	var pixels [][]byte
+
+	for {
+		fields, err := p.next()
+		if err != nil {
+			return err
+		}
+		if fields == nil {
+			return fmt.Errorf("received invalid BDF font: missing ENDCHAR")
+		}
+
+		switch fields[0] {
+		case "ENCODING":
+			vals, err := p.ints(fields, 1)
+			if err != nil {
+				return err
+			}
+			encoding = vals[0]
+		case "DWIDTH":
+			vals, err := p.ints(fields, 1)
+			if err != nil {
+				return err
+			}
+			advance = vals[0]
+		case "BBX":
+			vals, err := p.ints(fields, 4)
+			if err != nil {
+				return err
+			}
+			width, height, offsetX, offsetY = vals[0], vals[1], vals[2], vals[3]
+			if width < 0 || height < 0 || width > maxGlyphSize || height > maxGlyphSize {
+				return fmt.Errorf("received invalid BDF BBX on line %d", p.line)
+			}
+		case "BITMAP":
+			if pixels, err = p.parseBitmap(width, height); err != nil {
+				return err
+			}
+		case "ENDCHAR":
+			if encoding >= 0 {
+				p.glyphs[rune(encoding)] = Glyph{
+					Pixels:  pixels,
+					X:       offsetX,
+					Y:       p.ascent - height - offsetY,
+					Advance: advance,
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// parseBitmap reads the hex encoded rows of a glyph bitmap. The most significant bit of each
+// row is the leftmost pixel.
+func (p *bdfParser) parseBitmap(width, height int) ([][]byte, error) {
+	pixels := make([][]byte, height)
+	for y := range pixels {
+		fields, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if fields == nil {
+			return nil, fmt.Errorf("received invalid BDF font: bitmap ended early")
+		}
+		data, err := hex.DecodeString(fields[0])
+		if err != nil || len(data)*8 < width {
+			return nil, fmt.Errorf("received invalid BDF bitmap row %q on line %d", fields[0], p.line)
+		}
+		pixels[y] = unpackBits(data, width)
+	}
+	return pixels, nil
+}
+
+// ints parses the given number of integer arguments following the keyword.
+func (p *bdfParser) ints(fields []string, count int) ([]int, error) {
+	if len(fields) < count+1 {
+		return nil, fmt.Errorf("received invalid BDF %s on line %d", fields[0], p.line)
+	}
+	vals := make([]int, count)
+	for i := range vals {
+		val, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("received invalid BDF %s on line %d: %v", fields[0], p.line, err)
+		}
+		vals[i] = val
+	}
+	return vals, nil
+}
+
+// unpackBits expands a row of packed bits into full coverage pixels, most significant bit
+// first.
+func unpackBits(data []byte, width int) []byte {
+	row := make([]byte, width)
+	for x := range row {
+		if data[x/8]&(0x80>>uint(x%8)) != 0 {
+			row[x] = 255
+		}
+	}
+	return row
+}
@@ -0,0 +1,69 @@
+package scrollphathd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+const testBDF = `STARTFONT 2.1
+FONT -test-
+SIZE 4 75 75
+FONTBOUNDINGBOX 3 4 0 -1
+STARTPROPERTIES 2
+FONT_ASCENT 3
+FONT_DESCENT 1
+ENDPROPERTIES
+CHARS 2
+STARTCHAR period
+ENCODING 46
+DWIDTH 2 0
+BBX 1 1 0 0
+BITMAP
+80
+ENDCHAR
+STARTCHAR unencoded
+ENCODING -1
+DWIDTH 3 0
+BBX 1 1 0 0
+BITMAP
+80
+ENDCHAR
+ENDFONT
+`
+
+func TestLoadBDF(t *testing.T) {
+	font, err := scrollphathd.LoadBDF(strings.NewReader(testBDF))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font.Height() != 4 || font.Baseline() != 3 {
+		t.Fatalf("font height %d and baseline %d, expected 4 and 3", font.Height(), font.Baseline())
+	}
+	if font.Advance('.') != 2 {
+		t.Fatalf("advance was %d, expected 2", font.Advance('.'))
+	}
+
+	// The period sits on the baseline
+	dev, disp := getDisplay(scrollphathd.WithFont(font))
+	disp.WriteString(1, 0, ".", 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 0},
+		{0, 1, 0},
+	})
+}
+
+func TestLoadBDF_Invalid(t *testing.T) {
+	_, err := scrollphathd.LoadBDF(strings.NewReader(strings.Replace(testBDF, "ENDFONT", "", 1)))
+	if err == nil {
+		t.Fatal("expected error for truncated font")
+	}
+
+	_, err = scrollphathd.LoadBDF(strings.NewReader(strings.Replace(testBDF, "BBX 1 1 0 0", "BBX 1 -1 0 0", 1)))
+	if err == nil {
+		t.Fatal("expected error for negative bounding box")
+	}
+}
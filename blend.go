@@ -0,0 +1,79 @@
+package scrollphathd
+
+// BlendMode specifies how drawing operations combine new values with the existing values in
+// the buffer.
+type BlendMode int
+
+const (
+	// BlendReplace overwrites the existing value.
+	BlendReplace BlendMode = iota
+	// BlendAdd adds to the existing value, saturating at 255.
+	BlendAdd
+	// BlendMax keeps the brighter of the new and existing values.
+	BlendMax
+	// BlendMin keeps the dimmer of the new and existing values.
+	BlendMin
+	// BlendMultiply multiplies the values, treating 255 as 1.
+	BlendMultiply
+	// BlendXOR combines the bits of the values. Drawing with 255 inverts the existing value,
+	// and drawing the same value twice restores the original.
+	BlendXOR
+	// BlendAlpha mixes the new value over the existing value, weighted by the display's alpha.
+	BlendAlpha
+)
+
+// SetBlendMode configures how subsequent drawing operations combine new values with the
+// existing values in the buffer.
+func (d *Display) SetBlendMode(mode BlendMode) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.blendMode = mode
+}
+
+// SetBlendAlpha configures the opacity of new values when using BlendAlpha.
+// 0 is fully transparent, 255 is fully opaque.
+func (d *Display) SetBlendAlpha(alpha byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.alpha = alpha
+}
+
+// blend combines the new value with the existing value. Coverage gives how much of the pixel is
+// covered by the shape being drawn - for BlendAlpha it scales the alpha, and for other modes it
+// scales the new value.
+func (m BlendMode) blend(dst, src, alpha, coverage byte) byte {
+	if m == BlendAlpha {
+		alpha = scaleBrightness(alpha, coverage)
+		return byte((uint16(dst)*uint16(255-alpha) + uint16(src)*uint16(alpha) + 127) / 255)
+	}
+
+	src = scaleBrightness(src, coverage)
+	switch m {
+	case BlendAdd:
+		if sum := uint16(dst) + uint16(src); sum < 255 {
+			return byte(sum)
+		}
+		return 255
+	case BlendMax:
+		if src > dst {
+			return src
+		}
+		return dst
+	case BlendMin:
+		if src < dst {
+			return src
+		}
+		return dst
+	case BlendMultiply:
+		return scaleBrightness(dst, src)
+	case BlendXOR:
+		return dst ^ src
+	default:
+		return src
+	}
+}
+
+// scaleBrightness scales the given value by the given brightness.
+func scaleBrightness(val, brightness byte) byte {
+	return byte(uint16(val) * uint16(brightness) / 255)
+}
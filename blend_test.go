@@ -0,0 +1,59 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_BlendMode(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mode     scrollphathd.BlendMode
+		expected byte
+	}{
+		{name: "replace", mode: scrollphathd.BlendReplace, expected: 200},
+		{name: "add", mode: scrollphathd.BlendAdd, expected: 255},
+		{name: "max", mode: scrollphathd.BlendMax, expected: 200},
+		{name: "min", mode: scrollphathd.BlendMin, expected: 100},
+		{name: "multiply", mode: scrollphathd.BlendMultiply, expected: 78},
+		{name: "xor", mode: scrollphathd.BlendXOR, expected: 100 ^ 200},
+		{name: "alpha", mode: scrollphathd.BlendAlpha, expected: 150},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dev, disp := getDisplay()
+			disp.Fill(0, 0, 3, 1, 100)
+			disp.SetBlendMode(tc.mode)
+			disp.SetBlendAlpha(128)
+			disp.SetPixel(0, 0, 200)
+			disp.Show()
+			dev.checkPixels(t, [][]byte{
+				{tc.expected, 100, 100},
+				{0, 0, 0},
+				{0, 0, 0},
+			})
+		})
+	}
+}
+
+func TestDisplay_BlendXORInvert(t *testing.T) {
+	// Drawing a cursor twice restores the original content
+	dev, disp := getDisplay(scrollphathd.WithBlendMode(scrollphathd.BlendXOR))
+	disp.SetPixel(1, 1, 50)
+	disp.Fill(0, 1, 3, 1, 255)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{255, 205, 255},
+		{0, 0, 0},
+	})
+
+	disp.Fill(0, 1, 3, 1, 255)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 50, 0},
+		{0, 0, 0},
+	})
+}
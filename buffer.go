@@ -1,46 +1,149 @@
 package scrollphathd
 
-// resetBuffer will clear and recreate the buffer with the device's width and height.
-func (d *Display) resetBuffer() {
-	d.width = d.device.Width()
-	d.height = d.device.Height()
-	d.buffer = make([][]byte, d.height)
-	for y := range d.buffer {
-		d.buffer[y] = make([]byte, d.width)
+import (
+	"fmt"
+	"image"
+)
+
+// resetBuffer will clear and recreate the buffer with the device's width and height, or the
+// size of the viewport if the layer has one.
+func (l *Layer) resetBuffer() {
+	l.originX, l.originY = 0, 0
+	l.width = l.display.device.Width()
+	l.height = l.display.device.Height()
+	if !l.viewport.Empty() {
+		l.width, l.height = l.viewport.Dx(), l.viewport.Dy()
+	}
+	l.buffer = make([][]byte, l.height)
+	for y := range l.buffer {
+		l.buffer[y] = make([]byte, l.width)
 	}
 }
 
-// growBuffer will optionally grow the internal buffer as necessary to be able to capture
-// the given x, y coordinate.
-func (d *Display) growBuffer(newX, newY int) {
-	if newX < 0 || newY < 0 {
-		panic("coordinates must be 0 or greater")
+// Bounds returns the area currently covered by the layer's buffer. The minimum point is
+// negative if the buffer has grown left or up.
+func (l *Layer) Bounds() image.Rectangle {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	return l.bounds()
+}
+
+func (l *Layer) bounds() image.Rectangle {
+	return image.Rect(-l.originX, -l.originY, l.width-l.originX, l.height-l.originY)
+}
+
+// pixel returns the value at the given layer coordinate, or 0 if it is outside of the buffer.
+func (l *Layer) pixel(x, y int) byte {
+	x += l.originX
+	y += l.originY
+	if x < 0 || x >= l.width || y < 0 || y >= l.height {
+		return 0
 	}
-	if newX < d.width && newY < d.height {
+	return l.buffer[y][x]
+}
+
+// setPixel sets the value at the given layer coordinate, which must already be inside the
+// buffer.
+func (l *Layer) setPixel(x, y int, val byte) {
+	l.buffer[y+l.originY][x+l.originX] = val
+}
+
+// growBuffer will optionally grow the internal buffer as necessary to be able to capture
+// the given x, y coordinate. The buffer can grow in any direction - growing left or up moves
+// the origin, so existing content keeps its coordinates.
+func (l *Layer) growBuffer(newX, newY int) {
+	newX += l.originX
+	newY += l.originY
+	if newX >= 0 && newX < l.width && newY >= 0 && newY < l.height {
 		// Coords already within buffer
 		return
 	}
 
-	newWidth, newHeight := d.width, d.height
-	if newX >= newWidth {
-		newWidth = newX + 1
+	var left, top, right, bottom int
+	if newX < 0 {
+		left = -newX
+	} else if newX >= l.width {
+		right = newX - l.width + 1
 	}
-	if newY >= newHeight {
-		newHeight = newY + 1
+	if newY < 0 {
+		top = -newY
+	} else if newY >= l.height {
+		bottom = newY - l.height + 1
 	}
 
-	if newWidth > d.width {
-		for y, row := range d.buffer {
-			// Expand each row as needed
-			newRow := make([]byte, newWidth)
-			copy(newRow, row)
-			d.buffer[y] = newRow
+	newWidth := l.width + left + right
+	newHeight := l.height + top + bottom
+	buffer := make([][]byte, newHeight)
+	for y := range buffer {
+		if y >= top && y < top+l.height && left == 0 && right == 0 {
+			// Rows can be reused as-is if the width hasn't changed
+			buffer[y] = l.buffer[y-top]
+			continue
+		}
+		buffer[y] = make([]byte, newWidth)
+		if y >= top && y < top+l.height {
+			copy(buffer[y][left:], l.buffer[y-top])
 		}
 	}
-	for y := d.height - 1; y < newHeight; y++ {
-		// Append new rows as needed, of width x coord
-		d.buffer = append(d.buffer, make([]byte, newWidth))
+	l.buffer = buffer
+	l.width = newWidth
+	l.height = newHeight
+	l.originX += left
+	l.originY += top
+}
+
+// Resize resizes the layer's buffer to the given width and height, keeping its top left corner
+// in place. Content outside of the new size is discarded, and new space is blank.
+func (l *Layer) Resize(width, height int) error {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	min := l.bounds().Min
+	return l.crop(image.Rect(min.X, min.Y, min.X+width, min.Y+height))
+}
+
+// Crop changes the layer's buffer to cover exactly the given rectangle. Content outside of the
+// rectangle is discarded, and any new space is blank. Coordinates of the remaining content are
+// unchanged, so scrolling continues to work as before.
+func (l *Layer) Crop(rect image.Rectangle) error {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	return l.crop(rect)
+}
+
+func (l *Layer) crop(rect image.Rectangle) error {
+	if rect.Dx() <= 0 || rect.Dy() <= 0 {
+		return fmt.Errorf("received invalid buffer size %dx%d", rect.Dx(), rect.Dy())
+	}
+	buffer := make([][]byte, rect.Dy())
+	for y := range buffer {
+		buffer[y] = make([]byte, rect.Dx())
+		for x := range buffer[y] {
+			buffer[y][x] = l.pixel(rect.Min.X+x, rect.Min.Y+y)
+		}
+	}
+	l.buffer = buffer
+	l.width, l.height = rect.Dx(), rect.Dy()
+	l.originX, l.originY = -rect.Min.X, -rect.Min.Y
+	return nil
+}
+
+// TrimToContent crops the layer's buffer to the smallest rectangle that contains all of its lit
+// pixels, so that tiling wraps at the edges of the content. If there are no lit pixels, the
+// buffer is reset to its original size.
+func (l *Layer) TrimToContent() {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	var content image.Rectangle
+	for y, row := range l.buffer {
+		for x, val := range row {
+			if val > 0 {
+				content = content.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if content.Empty() {
+		l.resetBuffer()
+		return
 	}
-	d.width = newWidth
-	d.height = newHeight
+	_ = l.crop(content.Sub(image.Pt(l.originX, l.originY)))
 }
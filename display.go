@@ -1,6 +1,9 @@
 package scrollphathd
 
 import (
+	"image"
+	"sync"
+
 	"periph.io/x/periph/conn/i2c"
 )
 
@@ -45,28 +48,44 @@ func NewWithDevice(device Device, opts ...DisplayOption) *Display {
 	}
 
 	d := &Display{
-		options: options,
-		device:  device,
-		outBuf:  outBuf,
+		options:   options,
+		device:    device,
+		outBuf:    outBuf,
+		font:      options.font,
+		blendMode: options.blendMode,
+		alpha:     255,
+		state:     identityState,
 	}
-	d.resetBuffer()
+	d.layer = d.newLayer(BaseLayer, 0)
+	d.layers = []*Layer{d.layer}
 	return d
 }
 
 // Display is the primary struct for interacting with the Scroll pHAT HD device.
 type Display struct {
-	options displayOptions
-	device  Device
-	buffer  [][]byte
-	width, height,
-	scrollX, scrollY int
-	flipX, flipY bool
+	options   displayOptions
+	device    Device
+	font      Font
+	blendMode BlendMode
+	alpha     byte
+
+	// Layers are kept sorted by z-order. Drawing operations target the selected layer.
+	layers []*Layer
+	layer  *Layer
+
+	// Drawing operations are mapped through the current transform and clip rectangle
+	state      drawState
+	stateStack []drawState
 
 	// We maintain the output buffer for the device ourselves, to reduce the amount of
 	// memory allocation and copying that goes on
 	outBuf [][]byte
 
-	// TODO: Make this goroutine-safe? Would involve wrapping any buffer operations with a mutex.
+	// mu guards all of the display's state, including its layers. frame is held while
+	// rendering to the device, and for the duration of a Batch, so that frames are never shown
+	// half-drawn. frame must be acquired before mu if both are needed.
+	mu    sync.Mutex
+	frame sync.Mutex
 }
 
 // Device is an abstraction that defines the capabilities that the display requires from
@@ -82,72 +101,149 @@ type Device interface {
 // SetBrightness configures the display's brightness.
 // 0 is off, 255 is maximum brightness.
 func (d *Display) SetBrightness(brightness byte) {
+	d.frame.Lock()
+	defer d.frame.Unlock()
 	d.device.SetBrightness(brightness)
 }
 
-// SetFlip configures flipping for the display.
+// selected returns the selected layer.
+func (d *Display) selected() *Layer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.layer
+}
+
+// SetFlip configures flipping for the selected layer.
 func (d *Display) SetFlip(flipX, flipY bool) {
-	d.flipX = flipX
-	d.flipY = flipY
+	d.selected().SetFlip(flipX, flipY)
 }
 
-// ScrollTo configures the top left coordinate to use from the buffer for display.
+// ScrollTo configures the top left coordinate to use from the selected layer's buffer for
+// display.
 func (d *Display) ScrollTo(scrollX, scrollY int) {
-	d.scrollX = scrollX
-	d.scrollY = scrollY
+	d.selected().ScrollTo(scrollX, scrollY)
 }
 
-// Scroll scrolls the buffer relative to its current position.
+// Scroll scrolls the selected layer's buffer relative to its current position.
 func (d *Display) Scroll(deltaX, deltaY int) {
-	d.scrollX += deltaX
-	d.scrollY += deltaY
+	d.selected().Scroll(deltaX, deltaY)
+}
+
+// Resize resizes the selected layer's buffer to the given width and height, keeping its top
+// left corner in place. Content outside of the new size is discarded.
+func (d *Display) Resize(width, height int) error {
+	return d.selected().Resize(width, height)
+}
+
+// Crop changes the selected layer's buffer to cover exactly the given rectangle. Content
+// outside of the rectangle is discarded.
+func (d *Display) Crop(rect image.Rectangle) error {
+	return d.selected().Crop(rect)
 }
 
-// Show renders the current state of the display to the device. Scrolling and flipping are applied,
-// and the relevant subset of the display is sent to the device for actual rendering.
-func (d *Display) Show() {
+// TrimToContent crops the selected layer's buffer to the smallest rectangle that contains all
+// of its lit pixels. This is useful to reclaim memory after drawing large content, and to make
+// tiling wrap at the edges of the content.
+func (d *Display) TrimToContent() {
+	d.selected().TrimToContent()
+}
+
+// Show renders the current state of the display to the device. Scrolling and flipping are applied
+// to each layer, the layers are composited, and the relevant subset of the display is sent to the
+// device for actual rendering.
+// Returns any error from the device, after passing it to the error handler if one was given.
+func (d *Display) Show() error {
+	// The error handler is called once the frame mutex is released, so it can use the display
+	return d.handleError(d.showFrame())
+}
+
+// showFrame shows the display while holding the frame mutex.
+func (d *Display) showFrame() error {
+	d.frame.Lock()
+	defer d.frame.Unlock()
+	return d.show()
+}
+
+// show is like Show, for when the frame mutex is already held.
+func (d *Display) show() error {
+	d.composite()
+	d.device.SetBuffer(d.outBuf)
+	return d.device.Show()
+}
+
+// composite renders the layers into the output buffer.
+func (d *Display) composite() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	for y, row := range d.outBuf {
 		for x := range row {
-			row[x] = d.getSourcePixel(x, y)
+			row[x] = d.compositePixel(x, y)
 		}
 	}
-	d.device.SetBuffer(d.outBuf)
-	d.device.Show()
 }
 
-func (d *Display) getSourcePixel(devX, devY int) byte {
-	x := devX
-	x += d.scrollX
-	if d.options.tile {
-		x %= d.width
-	}
-	if d.flipX {
-		x = d.width - x - 1
+// handleError passes the given error to the error handler, if there is one, and returns it.
+func (d *Display) handleError(err error) error {
+	if err != nil && d.options.errorHandler != nil {
+		d.options.errorHandler(err)
 	}
+	return err
+}
 
-	// Fail early if x is nonsense
-	if x < 0 || x >= d.width {
-		return 0
-	}
+// Batch calls the given function while holding back Show, so that an update made up of multiple
+// drawing calls is never shown half-drawn, even if another goroutine is calling Show. The
+// function must not call Show, Clear, SetBrightness or Batch itself, or it will deadlock.
+// For example:
+//
+//	display.Batch(func() {
+//		display.ClearRect(0, 0, 5, 7)
+//		display.WriteString(0, 0, "42", 255)
+//	})
+//	display.Show()
+func (d *Display) Batch(fn func()) {
+	d.frame.Lock()
+	defer d.frame.Unlock()
+	fn()
+}
 
-	y := devY
-	y += d.scrollY
-	if d.options.tile {
-		y %= d.height
-	}
-	if d.flipY {
-		y = d.height - y - 1
+// compositePixel blends the visible layers at the given device coordinate, from the bottom of
+// the z-order to the top. Unlit pixels are transparent, as is everything outside of a layer's
+// viewport.
+func (d *Display) compositePixel(devX, devY int) byte {
+	var val byte
+	for _, layer := range d.layers {
+		if layer.hidden {
+			continue
+		}
+		x, y, ok := layer.viewportPixel(devX, devY)
+		if !ok {
+			continue
+		}
+		if src := layer.getSourcePixel(x, y); src > 0 {
+			val = BlendAlpha.blend(val, src, layer.opacity, 255)
+		}
 	}
+	return val
+}
 
-	if y < 0 || y >= d.height {
-		return 0
-	}
+// Clear clears the buffers of all layers, and shows the result.
+// Returns any error from the device, after passing it to the error handler if one was given.
+func (d *Display) Clear() error {
+	return d.handleError(d.clear())
+}
 
-	return d.buffer[y][x]
+// clear clears and shows the display while holding the frame mutex.
+func (d *Display) clear() error {
+	d.frame.Lock()
+	defer d.frame.Unlock()
+	d.resetLayers()
+	return d.show()
 }
 
-// Clear clears the entire display.
-func (d *Display) Clear() {
-	d.resetBuffer()
-	d.Show()
+func (d *Display) resetLayers() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, layer := range d.layers {
+		layer.resetBuffer()
+	}
 }
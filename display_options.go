@@ -10,10 +10,41 @@ func WithTiling(tile bool) DisplayOption {
 	}
 }
 
+// WithFont specifies the font used for rendering text (default Font5x7).
+func WithFont(font Font) DisplayOption {
+	return func(options *displayOptions) {
+		options.font = font
+	}
+}
+
+// WithBlendMode specifies how drawing operations combine new values with the existing values in
+// the buffer (default BlendReplace).
+func WithBlendMode(mode BlendMode) DisplayOption {
+	return func(options *displayOptions) {
+		options.blendMode = mode
+	}
+}
+
+// WithErrorHandler specifies a function that is called with any error returned by the device
+// when the display is shown or cleared. This is useful for callers that would rather not check
+// the error from every call to Show. Errors are still returned as usual.
+// The handler is called on the goroutine that called Show or Clear, and may safely use the
+// display.
+func WithErrorHandler(handler func(error)) DisplayOption {
+	return func(options *displayOptions) {
+		options.errorHandler = handler
+	}
+}
+
 type displayOptions struct {
-	tile bool
+	tile         bool
+	font         Font
+	blendMode    BlendMode
+	errorHandler func(error)
 }
 
 var defaultDisplayOptions = displayOptions{
-	tile: true,
+	tile:      true,
+	font:      Font5x7,
+	blendMode: BlendReplace,
 }
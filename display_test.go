@@ -1,6 +1,9 @@
 package scrollphathd_test
 
 import (
+	"errors"
+	"image"
+	"sync"
 	"testing"
 
 	"github.com/tomnz/scroll-phat-hd-go"
@@ -58,6 +61,63 @@ func TestDisplay_Scroll(t *testing.T) {
 	})
 }
 
+func TestDisplay_NegativeCoordinates(t *testing.T) {
+	// The buffer should grow left and up, without moving existing content
+	dev, disp := getDisplay(scrollphathd.WithTiling(false))
+	disp.SetPixel(1, 1, 7)
+	disp.SetPixel(-1, 0, 5)
+	disp.SetPixel(0, -1, 6)
+	if bounds := disp.Bounds(); bounds != image.Rect(-1, -1, 3, 3) {
+		t.Fatalf("unexpected bounds %v", bounds)
+	}
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 7, 0},
+		{0, 0, 0},
+	})
+
+	disp.ScrollTo(-1, -1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 6, 0},
+		{5, 0, 0},
+		{0, 0, 7},
+	})
+}
+
+func TestDisplay_Resize(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.SetPixel(5, 0, 1)
+
+	// Trimmed to a single pixel, which tiles across the whole display
+	disp.TrimToContent()
+	if bounds := disp.Bounds(); bounds != image.Rect(5, 0, 6, 1) {
+		t.Fatalf("unexpected bounds %v", bounds)
+	}
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 1, 1},
+		{1, 1, 1},
+		{1, 1, 1},
+	})
+
+	if err := disp.Resize(3, 3); err != nil {
+		t.Fatal(err)
+	}
+	disp.ScrollTo(5, 0)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 0, 0},
+		{0, 0, 0},
+		{0, 0, 0},
+	})
+
+	if err := disp.Crop(image.Rect(0, 0, 0, 3)); err == nil {
+		t.Fatal("expected error cropping to empty rectangle")
+	}
+}
+
 func TestDisplay_Tile(t *testing.T) {
 	dev, disp := getDisplay()
 	disp.SetPixel(0, 0, 1)
@@ -124,10 +184,68 @@ func TestDisplay_Flip(t *testing.T) {
 	})
 }
 
+func TestDisplay_Concurrent(t *testing.T) {
+	dev, disp := getDisplay()
+	sprite := scrollphathd.NewSprite([][]byte{{1}}, nil)
+	sprite.SetPosition(10, 10)
+	disp.AddSprite(sprite)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			disp.Batch(func() {
+				for y := 0; y < 3; y++ {
+					for x := 0; x < 3; x++ {
+						disp.SetPixel(x, y, byte(i))
+					}
+				}
+			})
+			disp.MoveSprites()
+		}
+	}()
+
+	// Every frame should be fully drawn with a single value
+	for i := 0; i < 100; i++ {
+		disp.Show()
+		for y, row := range dev.buffer {
+			for x, val := range row {
+				if val != dev.buffer[0][0] {
+					t.Fatalf("value at (%d, %d) was %d, expected %d", x, y, val, dev.buffer[0][0])
+				}
+			}
+		}
+	}
+	wg.Wait()
+}
+
+func TestDisplay_ShowError(t *testing.T) {
+	var handled []error
+	dev, disp := getDisplay(scrollphathd.WithErrorHandler(func(err error) {
+		handled = append(handled, err)
+	}))
+	if err := disp.Show(); err != nil {
+		t.Fatal(err)
+	}
+
+	dev.err = errors.New("device unplugged")
+	if err := disp.Show(); err != dev.err {
+		t.Fatalf("Show returned %v, expected %v", err, dev.err)
+	}
+	if err := disp.Clear(); err != dev.err {
+		t.Fatalf("Clear returned %v, expected %v", err, dev.err)
+	}
+	if len(handled) != 2 {
+		t.Fatalf("error handler was called %d times, expected 2", len(handled))
+	}
+}
+
 // testDevice is a fake device that implements the Device interface to validate output.
 // It's designed to display a 3x3 area.
 type testDevice struct {
 	buffer [][]byte
+	err    error
 }
 
 func (d *testDevice) Width() int                    { return 3 }
@@ -135,7 +253,7 @@ func (d *testDevice) Height() int                   { return 3 }
 func (d *testDevice) SetBuffer(buffer [][]byte)     { d.buffer = buffer }
 func (d *testDevice) SetBrightness(brightness byte) {}
 func (d *testDevice) Clear() error                  { return nil }
-func (d *testDevice) Show() error                   { return nil }
+func (d *testDevice) Show() error                   { return d.err }
 
 func getDisplay(opts ...scrollphathd.DisplayOption) (*testDevice, *scrollphathd.Display) {
 	dev := &testDevice{}
@@ -151,3 +269,15 @@ func (d *testDevice) checkPixels(t *testing.T, expected [][]byte) {
 		}
 	}
 }
+
+// checkBuffer compares the top left of the display's buffer, for drawings that are larger than
+// the test device.
+func checkBuffer(t *testing.T, disp *scrollphathd.Display, expected [][]byte) {
+	for y, row := range expected {
+		for x, val := range row {
+			if actual := disp.GetPixel(x, y); actual != val {
+				t.Fatalf("value at (%d, %d) was different (%d) than expected (%d)", x, y, actual, val)
+			}
+		}
+	}
+}
@@ -1,27 +1,232 @@
 package scrollphathd
 
-// TODO: Implement text and graphing methods for parity with Python lib
+import (
+	"image"
+	"math"
+)
 
-// SetPixel sets the given coordinate to the given value.
+// SetPixel sets the given coordinate to the given value, combined with the existing value
+// according to the blend mode. The coordinate is mapped through the current transform, and
+// is skipped if it falls outside of the clip rectangle.
 // Results must be explicitly pushed to the device with Show.
 func (d *Display) SetPixel(x, y int, val byte) {
-	d.growBuffer(x, y)
-	d.buffer[y][x] = val
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.setPixel(x, y, val)
 }
 
-// Fill fills the given rectable with the given value.
+func (d *Display) setPixel(x, y int, val byte) {
+	x, y, ok := d.toBuffer(x, y)
+	if !ok {
+		return
+	}
+	d.layer.growBuffer(x, y)
+	d.blendPixel(x, y, val, 255)
+}
+
+// plot sets the given coordinate to the given value, growing the buffer as needed.
+func (d *Display) plot(x, y int, val byte) {
+	d.plotCoverage(x, y, val, 255)
+}
+
+// plotCoverage is like plot, for pixels that are only partially covered by the shape being
+// drawn, such as anti-aliased edges.
+func (d *Display) plotCoverage(x, y int, val, coverage byte) {
+	x, y, ok := d.toBuffer(x, y)
+	if !ok {
+		return
+	}
+	d.layer.growBuffer(x, y)
+	d.blendPixel(x, y, val, coverage)
+}
+
+// putPixel sets the given coordinate to the given value, ignoring the blend mode.
+func (d *Display) putPixel(x, y int, val byte) {
+	x, y, ok := d.toBuffer(x, y)
+	if !ok {
+		return
+	}
+	d.layer.growBuffer(x, y)
+	d.layer.setPixel(x, y, val)
+}
+
+// blendPixel combines the given value with the existing value at the buffer coordinate, which
+// must already be inside the buffer.
+func (d *Display) blendPixel(x, y int, val, coverage byte) {
+	d.layer.setPixel(x, y, d.blendMode.blend(d.layer.pixel(x, y), val, d.alpha, coverage))
+}
+
+// getPixel returns the value at the given coordinate, or 0 if it is outside of the buffer.
+func (d *Display) getPixel(x, y int) byte {
+	x, y = d.state.transform(x, y)
+	return d.layer.pixel(x, y)
+}
+
+// inBuffer returns whether the given coordinate maps to a pixel inside both the buffer and
+// the clip rectangle.
+func (d *Display) inBuffer(x, y int) bool {
+	x, y, ok := d.toBuffer(x, y)
+	return ok && (image.Point{X: x, Y: y}).In(d.layer.bounds())
+}
+
+// growRect grows the buffer to cover the given rectangle, as far as it is visible through
+// the clip rectangle. Growing up front avoids reallocating the buffer for every new column
+// when drawing large areas pixel by pixel.
+func (d *Display) growRect(x, y, width, height int) {
+	rect := d.state.bufferRect(x, y, width, height)
+	if d.state.clipped {
+		rect = rect.Intersect(d.state.clip)
+	}
+	if rect.Empty() {
+		return
+	}
+	d.layer.growBuffer(rect.Min.X, rect.Min.Y)
+	d.layer.growBuffer(rect.Max.X-1, rect.Max.Y-1)
+}
+
+// Fill fills the given rectable with the given value, combined with the existing values
+// according to the blend mode.
 // Results must be explicitly pushed to the device with Show.
 func (d *Display) Fill(x, y, width, height int, val byte) {
-	d.growBuffer(x+width, y+height)
-	for ix := 0; ix < width; ix++ {
-		for iy := 0; iy < height; iy++ {
-			d.buffer[y+iy][x+ix] = val
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.fillFunc(x, y, width, height, func(int, int) byte { return val })
+}
+
+// ClearRect clears the given rectangle. The blend mode is ignored.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) ClearRect(x, y, width, height int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.clearRect(x, y, width, height)
+}
+
+func (d *Display) clearRect(x, y, width, height int) {
+	d.growRect(x, y, width, height)
+	for iy := y; iy < y+height; iy++ {
+		for ix := x; ix < x+width; ix++ {
+			d.putPixel(ix, iy, 0)
 		}
 	}
 }
 
-// ClearRect clears the given rectangle.
+// FloodFill sets the contiguous area of pixels that share the value at the given coordinate to
+// the given value. Pixels are connected to their 4 orthogonal neighbors. Only pixels inside the
+// current buffer and clip rectangle are filled.
 // Results must be explicitly pushed to the device with Show.
-func (d *Display) ClearRect(x, y, width, height int) {
-	d.Fill(x, y, width, height, 0)
+func (d *Display) FloodFill(x, y int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.floodFill(x, y, val, neighbors4)
+}
+
+// FloodFill8 is like FloodFill, except pixels are also connected to their diagonal neighbors.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FloodFill8(x, y int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.floodFill(x, y, val, neighbors8)
+}
+
+var (
+	neighbors4 = []image.Point{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}}
+	neighbors8 = []image.Point{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}, {X: 1, Y: 1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: -1, Y: -1}}
+)
+
+func (d *Display) floodFill(x, y int, val byte, neighbors []image.Point) {
+	start := image.Point{X: x, Y: y}
+	if !d.inBuffer(x, y) {
+		return
+	}
+	target := d.getPixel(x, y)
+
+	// Find the whole area before drawing anything, since blending may leave pixels with the
+	// same value as the target
+	area := pixelSet{}
+	area.add(x, y)
+	stack := []image.Point{start}
+	for len(stack) > 0 {
+		p := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, offset := range neighbors {
+			next := p.Add(offset)
+			if _, ok := area[next]; !ok && d.inBuffer(next.X, next.Y) && d.getPixel(next.X, next.Y) == target {
+				area[next] = struct{}{}
+				stack = append(stack, next)
+			}
+		}
+	}
+	d.drawSet(area, val)
+}
+
+// FillLinearGradient fills the given rectangle with a gradient that runs from fromVal at the
+// from coordinate to toVal at the to coordinate. Pixels beyond either end of the gradient take
+// the value of that end.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillLinearGradient(x, y, width, height int, from, to image.Point, fromVal, toVal byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+	length := dx*dx + dy*dy
+	d.fillFunc(x, y, width, height, func(px, py int) byte {
+		t := 0.0
+		if length > 0 {
+			t = (float64(px-from.X)*dx + float64(py-from.Y)*dy) / length
+		}
+		return lerpByte(fromVal, toVal, t)
+	})
+}
+
+// FillRadialGradient fills the given rectangle with a gradient that runs from innerVal at the
+// center coordinate to outerVal at the given radius. Pixels beyond the radius take the outer
+// value.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillRadialGradient(x, y, width, height int, center image.Point, radius float64, innerVal, outerVal byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.fillFunc(x, y, width, height, func(px, py int) byte {
+		t := 1.0
+		if radius > 0 {
+			t = math.Hypot(float64(px-center.X), float64(py-center.Y)) / radius
+		}
+		return lerpByte(innerVal, outerVal, t)
+	})
+}
+
+// FillPattern fills the given rectangle by tiling the given pattern, starting from the top left
+// corner of the rectangle.
+// Note that the pattern should be indexed in row, col order. Nothing is drawn if the pattern or
+// any of its rows are empty.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillPattern(x, y, width, height int, pattern [][]byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(pattern) == 0 {
+		return
+	}
+	for _, row := range pattern {
+		if len(row) == 0 {
+			return
+		}
+	}
+	d.fillFunc(x, y, width, height, func(px, py int) byte {
+		row := pattern[(py-y)%len(pattern)]
+		return row[(px-x)%len(row)]
+	})
+}
+
+// fillFunc sets each pixel in the given rectangle to the value returned for its coordinate.
+func (d *Display) fillFunc(x, y, width, height int, fn func(x, y int) byte) {
+	d.growRect(x, y, width, height)
+	for iy := y; iy < y+height; iy++ {
+		for ix := x; ix < x+width; ix++ {
+			d.plot(ix, iy, fn(ix, iy))
+		}
+	}
+}
+
+// lerpByte interpolates between the given values. t is clamped to the range 0 to 1.
+func lerpByte(from, to byte, t float64) byte {
+	t = math.Min(math.Max(t, 0), 1)
+	return byte(float64(from) + (float64(to)-float64(from))*t + 0.5)
 }
@@ -0,0 +1,61 @@
+package scrollphathd_test
+
+import (
+	"image"
+	"testing"
+)
+
+func TestDisplay_FloodFill(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.DrawLine(0, 2, 2, 0, 1)
+	disp.FloodFill(0, 0, 2)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{2, 2, 1},
+		{2, 1, 0},
+		{1, 0, 0},
+	})
+
+	// Diagonal connections leak through the line
+	dev, disp = getDisplay()
+	disp.DrawLine(0, 2, 2, 0, 1)
+	disp.FloodFill8(0, 0, 3)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{3, 3, 1},
+		{3, 1, 3},
+		{1, 3, 3},
+	})
+}
+
+func TestDisplay_FillGradient(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.FillLinearGradient(0, 0, 3, 3, image.Point{X: 0}, image.Point{X: 2}, 0, 100)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 50, 100},
+		{0, 50, 100},
+		{0, 50, 100},
+	})
+
+	disp.FillRadialGradient(0, 0, 3, 3, image.Point{X: 1, Y: 1}, 1, 200, 0)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 200, 0},
+		{0, 0, 0},
+	})
+}
+
+func TestDisplay_FillPattern(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.FillPattern(1, 0, 2, 3, [][]byte{{1, 0}, {0, 1}})
+	// Patterns with empty rows are ignored
+	disp.FillPattern(0, 0, 3, 3, [][]byte{{1}, {}})
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 1, 0},
+		{0, 0, 1},
+		{0, 1, 0},
+	})
+}
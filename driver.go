@@ -2,6 +2,9 @@ package scrollphathd
 
 import (
 	"fmt"
+	"image"
+	"image/color"
+	"sync"
 	"time"
 
 	"periph.io/x/periph/conn"
@@ -40,6 +43,9 @@ func NewDriverWithConn(periphConn conn.Conn, opts ...DriverOption) (*Driver, err
 	if err := d.setup(); err != nil {
 		return nil, err
 	}
+	if options.async {
+		d.startAsync()
+	}
 	return d, nil
 }
 
@@ -50,6 +56,12 @@ type Driver struct {
 	options driverOptions
 	// Device handle for I2C bus
 	i2c conn.Conn
+	// Held while communicating with the device, which may happen in the background in async
+	// mode
+	bus sync.Mutex
+	// Frames waiting to be written in async mode, or nil
+	queue  *frameQueue
+	errors chan error
 	// Hardware frame currently in use
 	frame         byte
 	buffer        [][]byte
@@ -79,6 +91,17 @@ func (s *Driver) SetPixel(x, y int, val byte) error {
 	return nil
 }
 
+// GetPixel returns the value of the pixel at the given coordinate in the internal buffer.
+func (s *Driver) GetPixel(x, y int) (byte, error) {
+	if x < 0 || x > s.width-1 {
+		return 0, fmt.Errorf("received invalid x coordinate %d", x)
+	}
+	if y < 0 || y > s.height-1 {
+		return 0, fmt.Errorf("received invalid y coordinate %d", y)
+	}
+	return s.buffer[y][x], nil
+}
+
 // SetPixels copies all of the given pixels at once to the internal buffer.
 // Dimensions of the incoming buffer are checked to ensure they match the width and height of
 // the device.
@@ -127,7 +150,22 @@ func (s *Driver) Clear() error {
 }
 
 // Show renders the contents of the internal buffer to the device. Brightness is applied.
+// If writing to the device fails, the device is re-initialized and the frame is sent again,
+// according to the retry options. See WithRetry.
+// In async mode, the frame is queued to be written in the background, and Show returns
+// immediately. Write errors are reported on the Errors channel instead, and Show only returns an
+// error if the driver has been closed. See WithAsync.
 func (s *Driver) Show() error {
+	frame := s.render()
+	if s.queue != nil {
+		return s.queue.push(frame)
+	}
+	return s.send(frame)
+}
+
+// render returns the values to write for each physical LED, after applying rotation,
+// brightness and gamma to the internal buffer.
+func (s *Driver) render() []byte {
 	// Maximum addressed LED is 134
 	output := make([]byte, 135)
 	for y, row := range s.buffer {
@@ -135,7 +173,42 @@ func (s *Driver) Show() error {
 			output[s.pixelAddr(x, y)] = s.options.gamma[s.scaleVal(val)]
 		}
 	}
+	return output
+}
+
+// send writes the given frame to the device, retrying according to the retry options.
+func (s *Driver) send(frame []byte) error {
+	s.bus.Lock()
+	cause := s.writeFrame(frame)
+	if cause == nil {
+		s.bus.Unlock()
+		return nil
+	}
+
+	err := cause
+	backoff := s.options.backoff
+	for retry := 0; retry < s.options.retries; retry++ {
+		time.Sleep(backoff)
+		backoff *= 2
+
+		if err = s.initialize(); err != nil {
+			continue
+		}
+		if err = s.writeFrame(frame); err == nil {
+			break
+		}
+	}
+	s.bus.Unlock()
+
+	// The handler is called without holding the bus, so that it can safely use the driver
+	if err == nil && s.options.reconnectHandler != nil {
+		s.options.reconnectHandler(cause)
+	}
+	return err
+}
 
+// writeFrame writes the given frame to the device, without retrying. The bus must be held.
+func (s *Driver) writeFrame(frame []byte) error {
 	nextFrame := (s.frame + 1) % 2
 	if err := s.bank(nextFrame); err != nil {
 		return err
@@ -145,7 +218,7 @@ func (s *Driver) Show() error {
 	// NOTE: Because we use the I2C bus directly (instead of via the SMBus subset) we can
 	// send all of the data at once, rather than chunking. This makes the data write atomic
 	// over the bus, eliminating the chance for someone else to interfere mid way.
-	if err := s.write(offsetColor, output...); err != nil {
+	if err := s.write(offsetColor, frame...); err != nil {
 		return err
 	}
 	// Switch the active frame to the new frame
@@ -186,11 +259,28 @@ func (s *Driver) pixelAddr(x, y int) int {
 	return x*16 + y
 }
 
-// setup performs initial setup of the I2C hardware device by sending initialization messages.
+// setup performs initial setup of the I2C hardware device by sending initialization messages,
+// then clears it.
 func (s *Driver) setup() error {
+	if err := s.initialize(); err != nil {
+		return err
+	}
+
+	s.buffer = make([][]byte, s.height)
+	for y := range s.buffer {
+		s.buffer[y] = make([]byte, s.width)
+	}
+
+	return s.Clear()
+}
+
+// initialize resets the device, and configures it for displaying frames. This is also used to
+// recover the device after a failure, so the internal buffer is left untouched.
+func (s *Driver) initialize() error {
 	if err := s.reset(); err != nil {
 		return err
 	}
+	s.frame = 0
 
 	if err := s.writeRegister(regFrame, 0); err != nil {
 		return err
@@ -218,16 +308,7 @@ func (s *Driver) setup() error {
 	if err := s.bank(0); err != nil {
 		return err
 	}
-	if err := s.write(offsetEnable, enableRows...); err != nil {
-		return err
-	}
-
-	s.buffer = make([][]byte, s.height)
-	for y := range s.buffer {
-		s.buffer[y] = make([]byte, s.width)
-	}
-
-	return s.Clear()
+	return s.write(offsetEnable, enableRows...)
 }
 
 // reset reboots the hardware device.
@@ -261,9 +342,59 @@ func (s *Driver) write(cmd byte, value ...byte) error {
 }
 
 // Halt implements devices.Device.
+// In async mode, queued frames are written first. Use Close to also stop the background
+// goroutine.
 func (s *Driver) Halt() error {
+	s.Flush()
+	s.bus.Lock()
+	defer s.bus.Unlock()
 	return s.writeRegister(regShutdown, 0)
 }
 
-// Ensure the device actually implements the periph.io interface.
-var _ devices.Device = &Driver{}
+// ColorModel implements devices.Display. The device only supports brightness, so it is
+// treated as grayscale.
+func (s *Driver) ColorModel() color.Model {
+	return color.GrayModel
+}
+
+// Bounds implements devices.Display. Rotation is taken into account.
+func (s *Driver) Bounds() image.Rectangle {
+	return image.Rect(0, 0, s.width, s.height)
+}
+
+// Draw implements devices.Display. The area of the source image starting at sp is converted to
+// grayscale and copied into the given rectangle of the internal buffer, which is then rendered
+// to the device. Any error from rendering is dropped, since the interface does not return one;
+// use SetPixels and Show instead if errors need to be handled.
+func (s *Driver) Draw(r image.Rectangle, src image.Image, sp image.Point) {
+	clipped := r.Intersect(s.Bounds())
+	// Shift the source point along with the clipped rectangle, as image/draw does
+	sp = sp.Add(clipped.Min.Sub(r.Min))
+	r = clipped
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			c := src.At(sp.X+x-r.Min.X, sp.Y+y-r.Min.Y)
+			s.buffer[y][x] = color.GrayModel.Convert(c).(color.Gray).Y
+		}
+	}
+	_ = s.Show()
+}
+
+// Write implements devices.Display. The data must contain exactly one gray byte per pixel, in
+// row order, covering the whole (rotated) device. The pixels are copied into the internal
+// buffer, which is then rendered to the device.
+func (s *Driver) Write(data []byte) (int, error) {
+	if len(data) != s.width*s.height {
+		return 0, fmt.Errorf("received invalid data of length %d - must be %d", len(data), s.width*s.height)
+	}
+	for y, row := range s.buffer {
+		copy(row, data[y*s.width:(y+1)*s.width])
+	}
+	if err := s.Show(); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Ensure the device actually implements the periph.io interfaces.
+var _ devices.Display = &Driver{}
@@ -0,0 +1,140 @@
+package scrollphathd
+
+import (
+	"fmt"
+	"sync"
+)
+
+// frameQueue holds rendered frames that are waiting to be written to the device in async mode.
+type frameQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	frames [][]byte
+	depth  int
+	policy QueuePolicy
+	// busy is set while a frame taken from the queue is being written
+	busy bool
+	// closed is set by Close. The remaining frames are still written, then the writer exits
+	closed bool
+	// stopped is closed once the writer has exited
+	stopped chan struct{}
+}
+
+// startAsync starts the background goroutine that writes queued frames to the device.
+func (s *Driver) startAsync() {
+	q := &frameQueue{
+		depth:   s.options.queueDepth,
+		policy:  s.options.queuePolicy,
+		stopped: make(chan struct{}),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	s.queue = q
+	s.errors = make(chan error, s.options.queueDepth)
+	go s.writeFrames()
+}
+
+// writeFrames writes queued frames to the device as they arrive, until the queue is closed.
+func (s *Driver) writeFrames() {
+	defer close(s.queue.stopped)
+	defer close(s.errors)
+	for {
+		frame, ok := s.queue.pop()
+		if !ok {
+			return
+		}
+		if err := s.send(frame); err != nil {
+			select {
+			case s.errors <- err:
+			default:
+				// Nobody is reading errors fast enough, so drop this one rather than blocking
+			}
+		}
+		s.queue.done()
+	}
+}
+
+// Errors returns a channel that receives errors from writing frames in async mode. The channel
+// is buffered, and errors are dropped if it is full. The channel is closed by Close. In
+// synchronous mode, the channel is nil and errors are returned from Show instead.
+func (s *Driver) Errors() <-chan error {
+	return s.errors
+}
+
+// Flush blocks until all queued frames have been written to the device in async mode. In
+// synchronous mode, it returns immediately.
+func (s *Driver) Flush() {
+	if s.queue != nil {
+		s.queue.wait()
+	}
+}
+
+// Close stops the background goroutine in async mode, after writing any queued frames to the
+// device. Once closed, Show returns an error. In synchronous mode, Close has no effect.
+func (s *Driver) Close() error {
+	if s.queue == nil {
+		return nil
+	}
+	s.queue.close()
+	<-s.queue.stopped
+	return nil
+}
+
+// push adds a frame to the queue, dropping a frame according to the policy if it is full.
+func (q *frameQueue) push(frame []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return fmt.Errorf("driver is closed")
+	}
+	if len(q.frames) >= q.depth {
+		if q.policy == QueueDropNewest {
+			return nil
+		}
+		q.frames = q.frames[1:]
+	}
+	q.frames = append(q.frames, frame)
+	q.cond.Broadcast()
+	return nil
+}
+
+// pop blocks until a frame is available, then removes it from the queue. done must be called
+// once the frame has been written. Returns false once the queue is closed and empty.
+func (q *frameQueue) pop() ([]byte, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.frames) == 0 && !q.closed {
+		q.cond.Wait()
+	}
+	if len(q.frames) == 0 {
+		return nil, false
+	}
+	frame := q.frames[0]
+	q.frames = q.frames[1:]
+	q.busy = true
+	return frame, true
+}
+
+// close marks the queue as closed, and wakes the writer.
+func (q *frameQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// done marks the frame returned by pop as written.
+func (q *frameQueue) done() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.busy = false
+	q.cond.Broadcast()
+}
+
+// wait blocks until the queue is empty and no frame is being written.
+func (q *frameQueue) wait() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.frames) > 0 || q.busy {
+		q.cond.Wait()
+	}
+}
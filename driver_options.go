@@ -1,6 +1,9 @@
 package scrollphathd
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // DriverOption allows specifying behavior for the driver.
 type DriverOption func(*driverOptions)
@@ -42,12 +45,71 @@ func WithRotation(rotation Rotation) DriverOption {
 	}
 }
 
+// WithRetry specifies how many times Show retries after failing to write to the device
+// (default 3), and how long to wait before the first retry (default 10ms). The wait doubles
+// with each subsequent retry. Before retrying, the device is re-initialized, in case it has lost
+// its configuration, for example after a brownout.
+func WithRetry(retries int, backoff time.Duration) DriverOption {
+	return func(options *driverOptions) {
+		if retries < 0 {
+			panic(fmt.Sprintf("received invalid retry count %d - must be 0 or greater", retries))
+		}
+		options.retries = retries
+		options.backoff = backoff
+	}
+}
+
+// WithReconnectHandler specifies a function that is called whenever the driver recovers from a
+// failed write by re-initializing the device. It is passed the error that caused the failure.
+// In async mode, the handler is called from the background goroutine, so it must not call
+// Flush or Halt.
+func WithReconnectHandler(handler func(cause error)) DriverOption {
+	return func(options *driverOptions) {
+		options.reconnectHandler = handler
+	}
+}
+
+// QueuePolicy specifies which frame is dropped when the async frame queue is full.
+type QueuePolicy int
+
+const (
+	// QueueDropOldest drops the oldest queued frame to make room for the new frame, so the
+	// device catches up to the latest frame as soon as possible.
+	QueueDropOldest QueuePolicy = iota
+	// QueueDropNewest drops the new frame, so every queued frame is eventually shown.
+	QueueDropNewest
+)
+
+// WithAsync makes Show non-blocking. Show renders the buffer into a frame and adds it to a queue
+// of the given depth, which a background goroutine writes to the device. If the queue is full,
+// a frame is dropped according to the given policy. Errors from writing frames are sent to the
+// Errors channel, and Flush waits for all queued frames to be written. Call Close to stop the
+// background goroutine once the driver is no longer needed.
+func WithAsync(depth int, policy QueuePolicy) DriverOption {
+	return func(options *driverOptions) {
+		if depth < 1 {
+			panic(fmt.Sprintf("received invalid queue depth %d - must be 1 or greater", depth))
+		}
+		options.async = true
+		options.queueDepth = depth
+		options.queuePolicy = policy
+	}
+}
+
 type driverOptions struct {
-	gamma    []byte
-	rotation Rotation
+	gamma            []byte
+	rotation         Rotation
+	retries          int
+	backoff          time.Duration
+	reconnectHandler func(cause error)
+	async            bool
+	queueDepth       int
+	queuePolicy      QueuePolicy
 }
 
 var defaultDriverOptions = driverOptions{
 	gamma:    defaultGamma,
 	rotation: Rotation0,
+	retries:  3,
+	backoff:  10 * time.Millisecond,
 }
@@ -1,9 +1,14 @@
 package scrollphathd
 
 import (
+	"errors"
 	"fmt"
+	"image"
+	"image/color"
+	"sync"
 	"testing"
 
+	"periph.io/x/periph/conn"
 	"periph.io/x/periph/conn/i2c/i2ctest"
 )
 
@@ -27,4 +32,174 @@ func TestDriver_New(t *testing.T) {
 	}
 }
 
+func TestDriver_Draw(t *testing.T) {
+	bus := &i2ctest.Record{}
+	driver, err := NewDriver(bus, WithRotation(Rotation90))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bounds := driver.Bounds(); bounds != image.Rect(0, 0, devHeight, devWidth) {
+		t.Fatalf("bounds were %v, expected rotated device size", bounds)
+	}
+
+	src := image.NewGray(image.Rect(0, 0, 2, 2))
+	src.SetGray(1, 1, color.Gray{Y: 100})
+	driver.Draw(image.Rect(5, 5, 7, 7), src, image.Point{})
+	if val := driver.buffer[6][6]; val != 100 {
+		t.Fatalf("value at (6, 6) was %d, expected 100", val)
+	}
+	if val := driver.buffer[5][5]; val != 0 {
+		t.Fatalf("value at (5, 5) was %d, expected 0", val)
+	}
+
+	// Partly off the device, so the source should be offset by the clipped amount
+	src = image.NewGray(image.Rect(0, 0, 4, 1))
+	src.SetGray(2, 0, color.Gray{Y: 50})
+	driver.Draw(image.Rect(-2, 0, 2, 1), src, image.Point{})
+	if val := driver.buffer[0][0]; val != 50 {
+		t.Fatalf("value at (0, 0) was %d, expected 50", val)
+	}
+	if val := driver.buffer[0][1]; val != 0 {
+		t.Fatalf("value at (1, 0) was %d, expected 0", val)
+	}
+}
+
+func TestDriver_Write(t *testing.T) {
+	driver, err := NewDriver(&i2ctest.Record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := make([]byte, devWidth*devHeight)
+	data[devWidth+2] = 80
+	if n, err := driver.Write(data); err != nil || n != len(data) {
+		t.Fatalf("Write returned %d (%v), expected %d", n, err, len(data))
+	}
+	if val := driver.buffer[1][2]; val != 80 {
+		t.Fatalf("value at (2, 1) was %d, expected 80", val)
+	}
+	if _, err := driver.Write(data[1:]); err == nil {
+		t.Fatal("expected error for data that doesn't cover the device")
+	}
+}
+
+func TestDriver_GetPixel(t *testing.T) {
+	driver, err := NewDriver(&i2ctest.Record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := driver.SetPixel(3, 2, 50); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := driver.GetPixel(3, 2); err != nil || val != 50 {
+		t.Fatalf("value at (3, 2) was %d (%v), expected 50", val, err)
+	}
+	if _, err := driver.GetPixel(devWidth, 0); err == nil {
+		t.Fatal("expected error for coordinate outside of the device")
+	}
+}
+
+func TestDriver_Retry(t *testing.T) {
+	c := &flakyConn{}
+	var causes []error
+	driver, err := NewDriverWithConn(c, WithRetry(2, 0), WithReconnectHandler(func(cause error) {
+		causes = append(causes, cause)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The device should be re-initialized, then the frame sent again
+	c.failures = 1
+	if err := driver.Show(); err != nil {
+		t.Fatal(err)
+	}
+	if len(causes) != 1 {
+		t.Fatalf("reconnect handler was called %d times, expected 1", len(causes))
+	}
+
+	// Give up once the retries are exhausted
+	c.failures = 3
+	if err := driver.Show(); err == nil {
+		t.Fatal("expected error once retries were exhausted")
+	}
+	if len(causes) != 1 {
+		t.Fatalf("reconnect handler was called %d times, expected 1", len(causes))
+	}
+}
+
+func TestDriver_Async(t *testing.T) {
+	c := &flakyConn{}
+	driver, err := NewDriverWithConn(c, WithRetry(0, 0), WithAsync(2, QueueDropOldest))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Errors are reported on the channel, rather than from Show
+	c.failures = 1
+	if err := driver.Show(); err != nil {
+		t.Fatal(err)
+	}
+	driver.Flush()
+	select {
+	case err := <-driver.Errors():
+		if err == nil {
+			t.Fatal("expected error from failed write")
+		}
+	default:
+		t.Fatal("expected error from failed write")
+	}
+
+	// Closing stops the writer, which closes the error channel
+	if err := driver.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-driver.Errors(); ok {
+		t.Fatal("expected error channel to be closed")
+	}
+	if err := driver.Show(); err == nil {
+		t.Fatal("expected error from Show after Close")
+	}
+}
+
+func TestFrameQueue_Policy(t *testing.T) {
+	testCases := []struct {
+		policy   QueuePolicy
+		expected []byte
+	}{
+		{policy: QueueDropOldest, expected: []byte{2, 3}},
+		{policy: QueueDropNewest, expected: []byte{1, 2}},
+	}
+	for _, tc := range testCases {
+		q := &frameQueue{depth: 2, policy: tc.policy}
+		q.cond = sync.NewCond(&q.mu)
+		for i := byte(1); i <= 3; i++ {
+			q.push([]byte{i})
+		}
+		for _, expected := range tc.expected {
+			if frame, _ := q.pop(); frame[0] != expected {
+				t.Fatalf("policy %d: frame was %d, expected %d", tc.policy, frame[0], expected)
+			}
+			q.done()
+		}
+	}
+}
+
+// flakyConn is a fake connection that fails the given number of transactions before
+// succeeding again.
+type flakyConn struct {
+	failures int
+}
+
+func (c *flakyConn) String() string { return "flaky" }
+
+func (c *flakyConn) Tx(w, r []byte) error {
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("bus error")
+	}
+	return nil
+}
+
+func (c *flakyConn) Duplex() conn.Duplex { return 0 }
+
 // TODO: Validate low level write behavior
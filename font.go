@@ -0,0 +1,273 @@
+package scrollphathd
+
+// Bundled fonts, for picking between density and legibility on the small display.
+var (
+	// Font3x5 is a tiny font with 3x5 pixel glyphs. Lowercase letters are rendered as uppercase.
+	Font3x5 = newColumnFont(font3x5, 5)
+	// Font5x5 is a compact font with 5x5 pixel glyphs. Lowercase letters are rendered as uppercase.
+	Font5x5 = newColumnFont(font5x5, 5)
+	// Font5x7 is the default font, with 5x7 pixel glyphs.
+	Font5x7 = newColumnFont(font5x7, 7)
+	// Font5x7Smoothed is Font5x7 with partially lit pixels that soften its curves and diagonals.
+	Font5x7Smoothed = newGrayFont(font5x7Smoothed, 5, 7)
+	// Font6x7Bold is a heavier font with 6x7 pixel glyphs, drawn with double width strokes.
+	Font6x7Bold = newColumnFont(font6x7Bold, 7)
+)
+
+// smoothCoverage is the coverage given to pixels that are filled in when smoothing glyphs. It
+// matches the stronger of the partial levels in Font5x7Smoothed, so that the pixels are still
+// visible once the device applies gamma correction.
+const smoothCoverage = 0xa0
+
+// Font provides glyphs for rendering text. Any implementation can be used for rendering text
+// on the Display - see the bundled fonts for some that are ready to go.
+type Font interface {
+	// Glyph returns the glyph for the given rune, and whether the font includes it.
+	Glyph(r rune) (Glyph, bool)
+	// Advance returns the horizontal distance in pixels from the start of the given rune to
+	// the start of the next, not including any letter spacing.
+	Advance(r rune) int
+	// Height returns the height of a line of text in pixels.
+	Height() int
+	// Baseline returns the distance in pixels from the top of a line of text to its baseline.
+	Baseline() int
+}
+
+// Glyph is the bitmap for a single character.
+type Glyph struct {
+	// Pixels holds the coverage of each pixel, from 0 (off) to 255 (fully lit). The coverage
+	// is scaled by the brightness that the text is rendered with.
+	// Note that the array is indexed in row, col order.
+	Pixels [][]byte
+	// X and Y give the offset of the top left corner of the bitmap from the pen position, which
+	// sits at the top of the line.
+	X, Y int
+	// Advance is the distance in pixels from the pen position to the start of the next glyph.
+	// If zero, BitmapFont uses the width of the bitmap instead.
+	Advance int
+}
+
+// Width returns the width of the glyph bitmap in pixels.
+func (g Glyph) Width() int {
+	if len(g.Pixels) == 0 {
+		return 0
+	}
+	return len(g.Pixels[0])
+}
+
+// Height returns the height of the glyph bitmap in pixels.
+func (g Glyph) Height() int {
+	return len(g.Pixels)
+}
+
+// maxGlyphSize is the largest glyph width or height accepted when loading fonts from files,
+// to guard against malformed files allocating huge amounts of memory.
+const maxGlyphSize = 256
+
+// NewBitmapFont returns a font made up of the given glyphs.
+func NewBitmapFont(glyphs map[rune]Glyph, height, baseline int) *BitmapFont {
+	return &BitmapFont{
+		glyphs:   glyphs,
+		height:   height,
+		baseline: baseline,
+	}
+}
+
+// BitmapFont is a Font backed by a fixed set of glyph bitmaps.
+type BitmapFont struct {
+	glyphs           map[rune]Glyph
+	height, baseline int
+}
+
+// Glyph implements Font.
+func (f *BitmapFont) Glyph(r rune) (Glyph, bool) {
+	glyph, ok := f.glyphs[r]
+	return glyph, ok
+}
+
+// Advance implements Font.
+func (f *BitmapFont) Advance(r rune) int {
+	glyph := f.glyphs[r]
+	if glyph.Advance > 0 {
+		return glyph.Advance
+	}
+	return glyph.Width()
+}
+
+// Height implements Font.
+func (f *BitmapFont) Height() int {
+	return f.height
+}
+
+// Baseline implements Font.
+func (f *BitmapFont) Baseline() int {
+	return f.baseline
+}
+
+// Smoothed returns a copy of the font with antialiased diagonals. Off pixels that sit in the
+// inner corner of a diagonal step are partially lit.
+func (f *BitmapFont) Smoothed() *BitmapFont {
+	return f.mapGlyphs(smoothGlyph)
+}
+
+// Bold returns a copy of the font with every glyph thickened by one pixel horizontally.
+func (f *BitmapFont) Bold() *BitmapFont {
+	return f.mapGlyphs(boldGlyph)
+}
+
+// Downsampled returns a copy of the font scaled down by the given factor. Each block of
+// factor x factor pixels is averaged into a single grayscale pixel, which gives antialiased
+// text from fonts that are too tall for the display.
+func (f *BitmapFont) Downsampled(factor int) *BitmapFont {
+	if factor < 1 {
+		panic("downsampling factor must be 1 or greater")
+	}
+	font := f.mapGlyphs(func(glyph Glyph) Glyph {
+		return downsampleGlyph(glyph, factor)
+	})
+	font.height = ceilDiv(f.height, factor)
+	font.baseline = ceilDiv(f.baseline, factor)
+	return font
+}
+
+func (f *BitmapFont) mapGlyphs(fn func(Glyph) Glyph) *BitmapFont {
+	glyphs := make(map[rune]Glyph, len(f.glyphs))
+	for r, glyph := range f.glyphs {
+		glyphs[r] = fn(glyph)
+	}
+	return NewBitmapFont(glyphs, f.height, f.baseline)
+}
+
+// newColumnFont builds a font from column-encoded glyph data, where the least significant bit
+// of each column is the top row. Lowercase letters fall back to their uppercase glyphs if the
+// data doesn't include them.
+func newColumnFont(data map[rune][]byte, height int) *BitmapFont {
+	glyphs := make(map[rune]Glyph, len(data))
+	for r, cols := range data {
+		pixels := make([][]byte, height)
+		for y := range pixels {
+			pixels[y] = make([]byte, len(cols))
+			for x, col := range cols {
+				if col&(1<<uint(y)) != 0 {
+					pixels[y][x] = 255
+				}
+			}
+		}
+		glyphs[r] = Glyph{Pixels: pixels}
+	}
+	for r := 'a'; r <= 'z'; r++ {
+		if _, ok := glyphs[r]; !ok {
+			if glyph, ok := glyphs[r-'a'+'A']; ok {
+				glyphs[r] = glyph
+			}
+		}
+	}
+	return NewBitmapFont(glyphs, height, height)
+}
+
+// newGrayFont builds a font from grayscale glyph data, where each glyph holds the coverage of
+// every pixel in row order.
+func newGrayFont(data map[rune][]byte, width, height int) *BitmapFont {
+	glyphs := make(map[rune]Glyph, len(data))
+	for r, vals := range data {
+		pixels := make([][]byte, height)
+		for y := range pixels {
+			pixels[y] = vals[y*width : (y+1)*width]
+		}
+		glyphs[r] = Glyph{Pixels: pixels}
+	}
+	return NewBitmapFont(glyphs, height, height)
+}
+
+// smoothGlyph partially lights any off pixel with two lit orthogonal neighbors that only touch
+// each other diagonally.
+func smoothGlyph(glyph Glyph) Glyph {
+	width, height := glyph.Width(), glyph.Height()
+	lit := func(x, y int) bool {
+		return x >= 0 && x < width && y >= 0 && y < height && glyph.Pixels[y][x] > 0
+	}
+
+	pixels := make([][]byte, height)
+	for y := range pixels {
+		pixels[y] = make([]byte, width)
+		copy(pixels[y], glyph.Pixels[y])
+		for x := range pixels[y] {
+			if lit(x, y) {
+				continue
+			}
+			for _, dx := range []int{-1, 1} {
+				for _, dy := range []int{-1, 1} {
+					if lit(x+dx, y) && lit(x, y+dy) && !lit(x+dx, y+dy) {
+						pixels[y][x] = smoothCoverage
+					}
+				}
+			}
+		}
+	}
+	return Glyph{Pixels: pixels, X: glyph.X, Y: glyph.Y, Advance: glyph.Advance}
+}
+
+// boldGlyph widens the glyph by one column, overlaying it with a copy of itself shifted right.
+func boldGlyph(glyph Glyph) Glyph {
+	pixels := make([][]byte, glyph.Height())
+	for y, row := range glyph.Pixels {
+		pixels[y] = make([]byte, len(row)+1)
+		copy(pixels[y], row)
+		for x, val := range row {
+			if val > pixels[y][x+1] {
+				pixels[y][x+1] = val
+			}
+		}
+	}
+	advance := glyph.Advance
+	if advance > 0 {
+		advance++
+	}
+	return Glyph{Pixels: pixels, X: glyph.X, Y: glyph.Y, Advance: advance}
+}
+
+// downsampleGlyph averages each block of factor x factor pixels into a single pixel. The
+// bitmap is aligned to the pen position grid first, so that glyphs stay consistent with each
+// other.
+func downsampleGlyph(glyph Glyph, factor int) Glyph {
+	minX, minY := floorDiv(glyph.X, factor), floorDiv(glyph.Y, factor)
+	maxX := ceilDiv(glyph.X+glyph.Width(), factor)
+	maxY := ceilDiv(glyph.Y+glyph.Height(), factor)
+
+	pixels := make([][]byte, maxY-minY)
+	for y := range pixels {
+		pixels[y] = make([]byte, maxX-minX)
+		for x := range pixels[y] {
+			total := 0
+			for sy := 0; sy < factor; sy++ {
+				gy := (minY+y)*factor + sy - glyph.Y
+				if gy < 0 || gy >= glyph.Height() {
+					continue
+				}
+				for sx := 0; sx < factor; sx++ {
+					gx := (minX+x)*factor + sx - glyph.X
+					if gx < 0 || gx >= glyph.Width() {
+						continue
+					}
+					total += int(glyph.Pixels[gy][gx])
+				}
+			}
+			pixels[y][x] = byte(total / (factor * factor))
+		}
+	}
+	return Glyph{Pixels: pixels, X: minX, Y: minY, Advance: ceilDiv(glyph.Advance, factor)}
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -ceilDiv(-a, b)
+	}
+	return a / b
+}
+
+func ceilDiv(a, b int) int {
+	if a < 0 {
+		return -floorDiv(-a, b)
+	}
+	return (a + b - 1) / b
+}
@@ -0,0 +1,74 @@
+package scrollphathd
+
+// font5x5 contains column-encoded glyphs for Font5x5.
+var font5x5 = map[rune][]byte{
+	' ':  {0x00, 0x00, 0x00, 0x00, 0x00},
+	'!':  {0x00, 0x00, 0x17, 0x00, 0x00},
+	'"':  {0x00, 0x03, 0x00, 0x03, 0x00},
+	'#':  {0x0a, 0x1f, 0x0a, 0x1f, 0x0a},
+	'$':  {0x12, 0x15, 0x1f, 0x15, 0x09},
+	'%':  {0x13, 0x0b, 0x04, 0x1a, 0x19},
+	'&':  {0x0a, 0x15, 0x15, 0x0a, 0x14},
+	'\'': {0x00, 0x00, 0x03, 0x00, 0x00},
+	'(':  {0x00, 0x00, 0x0e, 0x11, 0x00},
+	')':  {0x00, 0x11, 0x0e, 0x00, 0x00},
+	'*':  {0x15, 0x0e, 0x1f, 0x0e, 0x15},
+	'+':  {0x04, 0x04, 0x1f, 0x04, 0x04},
+	',':  {0x00, 0x10, 0x08, 0x00, 0x00},
+	'-':  {0x04, 0x04, 0x04, 0x04, 0x04},
+	'.':  {0x00, 0x00, 0x10, 0x00, 0x00},
+	'/':  {0x10, 0x08, 0x04, 0x02, 0x01},
+	'0':  {0x0e, 0x19, 0x15, 0x13, 0x0e},
+	'1':  {0x00, 0x12, 0x1f, 0x10, 0x00},
+	'2':  {0x19, 0x15, 0x15, 0x15, 0x12},
+	'3':  {0x11, 0x15, 0x15, 0x15, 0x0a},
+	'4':  {0x07, 0x04, 0x04, 0x1f, 0x04},
+	'5':  {0x17, 0x15, 0x15, 0x15, 0x09},
+	'6':  {0x0e, 0x15, 0x15, 0x15, 0x08},
+	'7':  {0x01, 0x19, 0x05, 0x03, 0x01},
+	'8':  {0x0a, 0x15, 0x15, 0x15, 0x0a},
+	'9':  {0x02, 0x15, 0x15, 0x15, 0x0e},
+	':':  {0x00, 0x00, 0x0a, 0x00, 0x00},
+	';':  {0x00, 0x10, 0x0a, 0x00, 0x00},
+	'<':  {0x00, 0x04, 0x0a, 0x11, 0x00},
+	'=':  {0x0a, 0x0a, 0x0a, 0x0a, 0x0a},
+	'>':  {0x00, 0x11, 0x0a, 0x04, 0x00},
+	'?':  {0x02, 0x01, 0x15, 0x05, 0x02},
+	'@':  {0x0e, 0x11, 0x1f, 0x1b, 0x06},
+	'A':  {0x1e, 0x05, 0x05, 0x05, 0x1e},
+	'B':  {0x1f, 0x15, 0x15, 0x15, 0x0a},
+	'C':  {0x0e, 0x11, 0x11, 0x11, 0x11},
+	'D':  {0x1f, 0x11, 0x11, 0x11, 0x0e},
+	'E':  {0x1f, 0x15, 0x15, 0x15, 0x11},
+	'F':  {0x1f, 0x05, 0x05, 0x05, 0x01},
+	'G':  {0x0e, 0x11, 0x11, 0x15, 0x0d},
+	'H':  {0x1f, 0x04, 0x04, 0x04, 0x1f},
+	'I':  {0x11, 0x11, 0x1f, 0x11, 0x11},
+	'J':  {0x08, 0x10, 0x10, 0x10, 0x0f},
+	'K':  {0x1f, 0x04, 0x04, 0x0a, 0x11},
+	'L':  {0x1f, 0x10, 0x10, 0x10, 0x10},
+	'M':  {0x1f, 0x02, 0x04, 0x02, 0x1f},
+	'N':  {0x1f, 0x02, 0x04, 0x08, 0x1f},
+	'O':  {0x0e, 0x11, 0x11, 0x11, 0x0e},
+	'P':  {0x1f, 0x05, 0x05, 0x05, 0x02},
+	'Q':  {0x0e, 0x11, 0x15, 0x09, 0x16},
+	'R':  {0x1f, 0x05, 0x05, 0x0d, 0x12},
+	'S':  {0x12, 0x15, 0x15, 0x15, 0x09},
+	'T':  {0x01, 0x01, 0x1f, 0x01, 0x01},
+	'U':  {0x0f, 0x10, 0x10, 0x10, 0x0f},
+	'V':  {0x07, 0x08, 0x10, 0x08, 0x07},
+	'W':  {0x1f, 0x08, 0x04, 0x08, 0x1f},
+	'X':  {0x11, 0x0a, 0x04, 0x0a, 0x11},
+	'Y':  {0x01, 0x02, 0x1c, 0x02, 0x01},
+	'Z':  {0x11, 0x19, 0x15, 0x13, 0x11},
+	'[':  {0x00, 0x1f, 0x11, 0x11, 0x00},
+	'\\': {0x01, 0x02, 0x04, 0x08, 0x10},
+	']':  {0x00, 0x11, 0x11, 0x1f, 0x00},
+	'^':  {0x04, 0x02, 0x01, 0x02, 0x04},
+	'_':  {0x10, 0x10, 0x10, 0x10, 0x10},
+	'`':  {0x00, 0x01, 0x02, 0x00, 0x00},
+	'{':  {0x00, 0x04, 0x1f, 0x11, 0x00},
+	'|':  {0x00, 0x00, 0x1f, 0x00, 0x00},
+	'}':  {0x00, 0x11, 0x1f, 0x04, 0x00},
+	'~':  {0x04, 0x02, 0x04, 0x08, 0x04},
+}
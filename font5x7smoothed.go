@@ -0,0 +1,861 @@
+package scrollphathd
+
+// font5x7Smoothed contains grayscale glyphs for Font5x7Smoothed, covering printable ASCII. Each
+// glyph holds one coverage value per pixel, in row order. The fully lit pixels match font5x7.
+var font5x7Smoothed = map[rune][]byte{
+	' ': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'!': {
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'"': {
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'#': {
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+		0x00, 0xff, 0x00, 0xff, 0x00,
+	},
+	'$': {
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xa0, 0xff, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0xff, 0xa0, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'%': {
+		0xff, 0xff, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0x00, 0x00, 0xff,
+		0x00, 0x00, 0x00, 0xff, 0xa0,
+		0x00, 0x00, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xff, 0xff,
+	},
+	'&': {
+		0x60, 0xff, 0xff, 0x60, 0x00,
+		0xff, 0x00, 0x00, 0xff, 0x00,
+		0xff, 0xa0, 0xff, 0x60, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0x00, 0xff, 0xa0, 0xff,
+		0xff, 0x00, 0x00, 0xff, 0xa0,
+		0x60, 0xff, 0xff, 0x00, 0xff,
+	},
+	'\'': {
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'(': {
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0xa0, 0xff, 0x60, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0x60, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+	},
+	')': {
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0x60, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x60, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+	},
+	'*': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0xff, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'+': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	',': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+	},
+	'-': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'.': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+	},
+	'/': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'0': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0xa0, 0xff,
+		0xff, 0x00, 0xa0, 0xff, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0xff, 0xff, 0xa0, 0x00, 0xff,
+		0xff, 0xa0, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'1': {
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'2': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'3': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'4': {
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0xa0, 0xff, 0xff, 0x00,
+		0xa0, 0xff, 0xa0, 0xff, 0x00,
+		0xff, 0xa0, 0x00, 0xff, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+	},
+	'5': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'6': {
+		0x00, 0x60, 0xff, 0xff, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'7': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+	},
+	'8': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'9': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x60, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xff, 0xff, 0x60, 0x00,
+	},
+	':': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	';': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+	},
+	'<': {
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+	},
+	'=': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'>': {
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+	},
+	'?': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0x00, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'@': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0x00, 0xff,
+		0xff, 0xa0, 0xff, 0x00, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'A': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'B': {
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+	},
+	'C': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'D': {
+		0xff, 0xff, 0xff, 0x60, 0x00,
+		0xff, 0x00, 0x60, 0xff, 0xa0,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x60, 0xff, 0xa0,
+		0xff, 0xff, 0xff, 0x60, 0x00,
+	},
+	'E': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'F': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+	},
+	'G': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0xff, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'H': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'I': {
+		0x00, 0xff, 0xff, 0xff, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'J': {
+		0x00, 0x00, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0xff, 0x60, 0x00, 0xff, 0x00,
+		0x60, 0xff, 0xff, 0x60, 0x00,
+	},
+	'K': {
+		0xff, 0x00, 0x00, 0xa0, 0xff,
+		0xff, 0x00, 0xa0, 0xff, 0xa0,
+		0xff, 0xa0, 0xff, 0xa0, 0x00,
+		0xff, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0xff, 0xa0, 0x00,
+		0xff, 0x00, 0xa0, 0xff, 0xa0,
+		0xff, 0x00, 0x00, 0xa0, 0xff,
+	},
+	'L': {
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'M': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xff, 0xa0, 0xff, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'N': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xff, 0xa0, 0x00, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0xff, 0x00, 0xa0, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'O': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'P': {
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+	},
+	'Q': {
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0xff, 0x60, 0xff,
+		0xff, 0x60, 0xa0, 0xff, 0xa0,
+		0x60, 0xff, 0xff, 0xa0, 0xff,
+	},
+	'R': {
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0xff, 0xa0, 0x00,
+		0xff, 0x00, 0xa0, 0xff, 0xa0,
+		0xff, 0x00, 0x00, 0xa0, 0xff,
+	},
+	'S': {
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0x60, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+	},
+	'T': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'U': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'V': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+	},
+	'W': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0xff, 0x00, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0xff, 0xff, 0xa0, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'X': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'Y': {
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'Z': {
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'[': {
+		0x00, 0xff, 0xff, 0xff, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'\\': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0x00, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0x00, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	']': {
+		0x00, 0xff, 0xff, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'^': {
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'_': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'`': {
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+	'a': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x60, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+	},
+	'b': {
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0xff, 0xff, 0x60,
+		0xff, 0xff, 0x60, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+	},
+	'c': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x00,
+		0xff, 0x60, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'd': {
+		0x00, 0x00, 0x00, 0x00, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0x60, 0xff,
+		0xff, 0x00, 0x60, 0xff, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+	},
+	'e': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x60, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x00,
+	},
+	'f': {
+		0x00, 0x60, 0xff, 0xff, 0x60,
+		0x00, 0xff, 0x00, 0x60, 0xff,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+	},
+	'g': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x60, 0xff,
+		0x00, 0xff, 0xff, 0xff, 0x60,
+	},
+	'h': {
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0xff, 0xff, 0x60,
+		0xff, 0xff, 0x60, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'i': {
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'j': {
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0x00, 0x00, 0x00, 0xff, 0x00,
+		0xff, 0x60, 0x00, 0xff, 0x00,
+		0x60, 0xff, 0xff, 0x60, 0x00,
+	},
+	'k': {
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0xa0, 0xff,
+		0x00, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xff, 0xff, 0xa0, 0x00,
+		0x00, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xff, 0x00, 0xa0, 0xff,
+	},
+	'l': {
+		0x00, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xff, 0xff, 0x00,
+	},
+	'm': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0x60, 0xff, 0x60,
+		0xff, 0x60, 0xff, 0x60, 0xff,
+		0xff, 0x00, 0xff, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'n': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0xff, 0xff, 0x60,
+		0xff, 0xff, 0x60, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+	},
+	'o': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x60, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+	},
+	'p': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+	},
+	'q': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0x60, 0xff,
+		0xff, 0x60, 0x00, 0xff, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0xff,
+		0x00, 0x00, 0x00, 0x00, 0xff,
+	},
+	'r': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x60, 0xff, 0xff, 0x60,
+		0xff, 0xff, 0x60, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0x00,
+	},
+	's': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x00,
+		0xff, 0x60, 0x00, 0x00, 0x00,
+		0x60, 0xff, 0xff, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0x60, 0xff,
+		0xff, 0xff, 0xff, 0xff, 0x60,
+	},
+	't': {
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x00, 0x00,
+		0x00, 0xff, 0x00, 0x60, 0xff,
+		0x00, 0x60, 0xff, 0xff, 0x60,
+	},
+	'u': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0xff, 0xff,
+		0x60, 0xff, 0xff, 0x60, 0xff,
+	},
+	'v': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+	},
+	'w': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x00, 0xff, 0x00, 0xff,
+		0xff, 0xa0, 0xff, 0xa0, 0xff,
+		0x60, 0xff, 0x60, 0xff, 0x60,
+	},
+	'x': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0xff, 0xa0,
+		0xff, 0xa0, 0x00, 0xa0, 0xff,
+	},
+	'y': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x60, 0x00, 0x00, 0xff,
+		0x60, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0x00, 0x60, 0xff,
+		0x00, 0xff, 0xff, 0xff, 0x60,
+	},
+	'z': {
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+		0x00, 0x00, 0xa0, 0xff, 0xa0,
+		0x00, 0xa0, 0xff, 0xa0, 0x00,
+		0xa0, 0xff, 0xa0, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff, 0xff,
+	},
+	'{': {
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0xff, 0x60, 0x00,
+		0x00, 0xa0, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0xa0, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x60, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+	},
+	'|': {
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0x00, 0x00,
+	},
+	'}': {
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+		0x00, 0x60, 0xff, 0x00, 0x00,
+		0x00, 0x00, 0xff, 0xa0, 0x00,
+		0x00, 0x00, 0xa0, 0xff, 0x00,
+		0x00, 0x00, 0xff, 0xa0, 0x00,
+		0x00, 0x60, 0xff, 0x00, 0x00,
+		0x00, 0xff, 0xa0, 0x00, 0x00,
+	},
+	'~': {
+		0x60, 0xff, 0x60, 0x00, 0x00,
+		0xff, 0x60, 0xff, 0x60, 0xff,
+		0x00, 0x00, 0x60, 0xff, 0x60,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00,
+	},
+}
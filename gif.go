@@ -0,0 +1,164 @@
+package scrollphathd
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"sync"
+	"time"
+)
+
+// defaultGIFDelay is used for frames that don't specify a delay, consistent with how most
+// browsers play animations.
+const defaultGIFDelay = 100 * time.Millisecond
+
+// NewGIFPlayer returns a player for the given animation, which renders into the given display.
+// The animation should be fully decoded, e.g. with gif.DecodeAll.
+func NewGIFPlayer(display *Display, anim *gif.GIF, opts ...GIFOption) *GIFPlayer {
+	options := defaultGIFOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return &GIFPlayer{
+		options: options,
+		display: display,
+		anim:    anim,
+	}
+}
+
+// GIFPlayer plays an animated GIF on a Display. Frames are composited according to their
+// disposal methods, converted to grayscale, and shown for their specified delay.
+type GIFPlayer struct {
+	options gifOptions
+	display *Display
+	anim    *gif.GIF
+
+	mu sync.Mutex
+	// resume is non-nil while the player is paused, and is closed to resume playback
+	resume chan struct{}
+}
+
+// Play renders the animation to the display, calling Show for each frame. Blocks until the
+// animation has played the number of times given by its loop count, or the context is
+// cancelled, in which case the context's error is returned. If showing a frame fails, playback
+// stops and the device's error is returned.
+func (p *GIFPlayer) Play(ctx context.Context) error {
+	if len(p.anim.Image) == 0 {
+		return fmt.Errorf("received invalid animation with no frames")
+	}
+	loopCount := p.anim.LoopCount
+	if p.options.loopCount != nil {
+		loopCount = *p.options.loopCount
+	}
+	plays := loopCount + 1
+	if loopCount == -1 {
+		plays = 1
+	}
+
+	for play := 0; loopCount == 0 || play < plays; play++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := p.playOnce(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pause pauses playback after the current frame. Has no effect if already paused.
+func (p *GIFPlayer) Pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resume == nil {
+		p.resume = make(chan struct{})
+	}
+}
+
+// Resume resumes paused playback. Has no effect if not paused.
+func (p *GIFPlayer) Resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resume != nil {
+		close(p.resume)
+		p.resume = nil
+	}
+}
+
+// playOnce plays through all of the frames of the animation.
+func (p *GIFPlayer) playOnce(ctx context.Context) error {
+	canvas := image.NewRGBA(p.bounds())
+	var previous *image.RGBA
+
+	for i, frame := range p.anim.Image {
+		disposal := byte(0)
+		if i < len(p.anim.Disposal) {
+			disposal = p.anim.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(canvas.Bounds())
+			copy(previous.Pix, canvas.Pix)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		p.display.DrawImage(canvas, p.options.imageOpts...)
+		if err := p.display.Show(); err != nil {
+			return err
+		}
+
+		delay := defaultGIFDelay
+		if i < len(p.anim.Delay) && p.anim.Delay[i] > 0 {
+			delay = time.Duration(p.anim.Delay[i]) * 10 * time.Millisecond
+		}
+		if err := p.wait(ctx, delay); err != nil {
+			return err
+		}
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+	return nil
+}
+
+// bounds returns the logical screen size of the animation, falling back to the union of the
+// frame bounds if the config wasn't decoded.
+func (p *GIFPlayer) bounds() image.Rectangle {
+	if p.anim.Config.Width > 0 && p.anim.Config.Height > 0 {
+		return image.Rect(0, 0, p.anim.Config.Width, p.anim.Config.Height)
+	}
+	var bounds image.Rectangle
+	for _, frame := range p.anim.Image {
+		bounds = bounds.Union(frame.Bounds())
+	}
+	return bounds
+}
+
+// wait blocks for the given delay, and then for as long as the player is paused.
+func (p *GIFPlayer) wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+	}
+
+	p.mu.Lock()
+	resume := p.resume
+	p.mu.Unlock()
+	if resume == nil {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-resume:
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package scrollphathd
+
+import "fmt"
+
+// GIFOption allows specifying behavior for a GIFPlayer.
+type GIFOption func(*gifOptions)
+
+// WithGIFLoopCount overrides the loop count that is stored in the animation. A loop count of
+// 0 loops forever, -1 plays each frame once, and otherwise the animation is played LoopCount+1
+// times, consistent with image/gif.
+func WithGIFLoopCount(loopCount int) GIFOption {
+	return func(options *gifOptions) {
+		if loopCount < -1 {
+			panic(fmt.Sprintf("received invalid loop count %d - must be -1 or greater", loopCount))
+		}
+		options.loopCount = &loopCount
+	}
+}
+
+// WithGIFImageOptions specifies how each frame is rendered into the buffer. For example,
+// WithImageRegion can be used to render the animation into a sub-region of the buffer, leaving
+// the rest untouched. Frames are cropped to the region if they don't fit.
+func WithGIFImageOptions(opts ...ImageOption) GIFOption {
+	return func(options *gifOptions) {
+		options.imageOpts = opts
+	}
+}
+
+type gifOptions struct {
+	loopCount *int
+	imageOpts []ImageOption
+}
+
+var defaultGIFOptions = gifOptions{}
@@ -0,0 +1,69 @@
+package scrollphathd_test
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/gif"
+	"testing"
+	"time"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func getTestGIF() *gif.GIF {
+	palette := color.Palette{color.Transparent, color.White}
+	first := image.NewPaletted(image.Rect(0, 0, 2, 2), palette)
+	first.SetColorIndex(0, 0, 1)
+	second := image.NewPaletted(image.Rect(1, 1, 2, 2), palette)
+	second.SetColorIndex(1, 1, 1)
+
+	return &gif.GIF{
+		Image:    []*image.Paletted{first, second},
+		Delay:    []int{1, 1},
+		Disposal: []byte{gif.DisposalBackground, gif.DisposalNone},
+		Config:   image.Config{Width: 2, Height: 2},
+	}
+}
+
+func TestGIFPlayer(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.SetPixel(0, 2, 7)
+	disp.SetPixel(2, 0, 5)
+	disp.SetPixel(2, 1, 9)
+	player := scrollphathd.NewGIFPlayer(disp, getTestGIF(),
+		scrollphathd.WithGIFLoopCount(-1),
+		scrollphathd.WithGIFImageOptions(scrollphathd.WithImageRegion(1, 0, 1, 2)),
+	)
+	if err := player.Play(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first frame was disposed of, and the frames are cropped to the region, leaving the
+	// rest of the buffer untouched
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 5},
+		{0, 0, 9},
+		{7, 0, 0},
+	})
+}
+
+func TestGIFPlayer_Cancel(t *testing.T) {
+	_, disp := getDisplay()
+	player := scrollphathd.NewGIFPlayer(disp, getTestGIF(), scrollphathd.WithGIFLoopCount(0))
+	player.Pause()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := player.Play(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestGIFPlayer_NoFrames(t *testing.T) {
+	_, disp := getDisplay()
+	player := scrollphathd.NewGIFPlayer(disp, &gif.GIF{}, scrollphathd.WithGIFLoopCount(0))
+	if err := player.Play(context.Background()); err == nil {
+		t.Fatal("expected error for animation with no frames")
+	}
+}
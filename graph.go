@@ -0,0 +1,74 @@
+package scrollphathd
+
+import "math"
+
+// DrawGraph renders the given values as a column graph, with each value drawn as a vertical
+// bar rising from the bottom of the graph region. Values are scaled between the low and high
+// bounds of the graph, and the top pixel of each bar is partially lit to show fractional values.
+// If the bounds are equal, as they are when every value is the same, values at the bound are
+// drawn as full columns.
+// The graph region is cleared first. If there are more values than columns in the region, the
+// most recent (last) values are shown.
+// Like other drawing operations, the graph is drawn into the selected layer through the current
+// transform. Use a Sparkline for a graph that is updated incrementally.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawGraph(values []float64, opts ...GraphOption) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	options := defaultGraphOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.width < 0 {
+		options.width = len(values)
+	}
+	if options.height < 0 {
+		options.height = d.device.Height()
+	}
+	if options.width == 0 || options.height == 0 {
+		return
+	}
+
+	if len(values) > options.width {
+		values = values[len(values)-options.width:]
+	}
+	if options.autoRange {
+		options.low, options.high = math.Inf(1), math.Inf(-1)
+		for _, val := range values {
+			options.low = math.Min(options.low, val)
+			options.high = math.Max(options.high, val)
+		}
+	}
+	span := options.high - options.low
+
+	d.clearRect(options.x, options.y, options.width, options.height)
+	for col, val := range values {
+		level := 0.0
+		switch {
+		case span > 0:
+			level = (val - options.low) / span * float64(options.height)
+		case val >= options.high:
+			// There's no range to scale within, e.g. for a constant series, so values at the
+			// top of the range are drawn as full columns
+			level = float64(options.height)
+		}
+		for row := 0; row < options.height; row++ {
+			fill := math.Min(math.Max(level-float64(row), 0), 1)
+			if fill == 0 {
+				break
+			}
+			brightness := options.rowBrightness(row)
+			d.setPixel(options.x+col, options.y+options.height-1-row, byte(fill*float64(brightness)))
+		}
+	}
+}
+
+// rowBrightness returns the brightness for the given row of the graph, counting up from the
+// bottom.
+func (o graphOptions) rowBrightness(row int) byte {
+	if o.height < 2 {
+		return o.top
+	}
+	bottom, top := int(o.bottom), int(o.top)
+	return byte(bottom + (top-bottom)*row/(o.height-1))
+}
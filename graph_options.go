@@ -0,0 +1,52 @@
+package scrollphathd
+
+// GraphOption allows specifying behavior when rendering graphs.
+type GraphOption func(*graphOptions)
+
+// WithGraphRange specifies the values that map to an empty column and a full column. Values
+// outside of the range are clamped. By default, the minimum and maximum of the values are used.
+func WithGraphRange(low, high float64) GraphOption {
+	return func(options *graphOptions) {
+		options.low, options.high = low, high
+		options.autoRange = false
+	}
+}
+
+// WithGraphRegion specifies the area of the buffer that the graph is rendered into. By default,
+// the graph starts at the origin, with one column per value, and the height of the device.
+func WithGraphRegion(x, y, width, height int) GraphOption {
+	return func(options *graphOptions) {
+		options.x, options.y = x, y
+		options.width, options.height = width, height
+	}
+}
+
+// WithGraphBrightness specifies the brightness of lit pixels (default 255).
+func WithGraphBrightness(brightness byte) GraphOption {
+	return func(options *graphOptions) {
+		options.bottom, options.top = brightness, brightness
+	}
+}
+
+// WithGraphGradient specifies a brightness gradient for each column, from the given brightness
+// at the bottom row of the graph to the given brightness at the top row.
+func WithGraphGradient(bottom, top byte) GraphOption {
+	return func(options *graphOptions) {
+		options.bottom, options.top = bottom, top
+	}
+}
+
+type graphOptions struct {
+	autoRange           bool
+	low, high           float64
+	x, y, width, height int
+	bottom, top         byte
+}
+
+var defaultGraphOptions = graphOptions{
+	autoRange: true,
+	width:     -1,
+	height:    -1,
+	bottom:    255,
+	top:       255,
+}
@@ -0,0 +1,36 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_DrawGraph(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.DrawGraph([]float64{0, 1.5, 3}, scrollphathd.WithGraphRange(0, 3), scrollphathd.WithGraphBrightness(200))
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 200},
+		{0, 100, 200},
+		{0, 200, 200},
+	})
+
+	// Only the most recent values fit in the region, and the range is taken from them
+	disp.DrawGraph([]float64{5, 1, 2}, scrollphathd.WithGraphRegion(1, 0, 2, 3), scrollphathd.WithGraphGradient(30, 90))
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 90},
+		{0, 0, 60},
+		{0, 0, 30},
+	})
+
+	// A constant series has no range to scale within, so it's drawn as full columns
+	disp.DrawGraph([]float64{3, 3, 3}, scrollphathd.WithGraphBrightness(50))
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{50, 50, 50},
+		{50, 50, 50},
+		{50, 50, 50},
+	})
+}
@@ -0,0 +1,46 @@
+package scrollphathd
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// ColorModel implements image.Image. The display is treated as a grayscale image, where each
+// pixel's luminance is its brightness.
+func (d *Display) ColorModel() color.Model {
+	return color.GrayModel
+}
+
+// Bounds implements image.Image. The bounds cover the current extent of the selected layer's
+// buffer, and grow along with it. If the buffer has grown left or up, the minimum point is
+// negative.
+func (d *Display) Bounds() image.Rectangle {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.layer.bounds()
+}
+
+// At implements image.Image. Pixels outside of the buffer are black.
+// Like Bounds, coordinates are buffer coordinates, so the drawing transform is ignored.
+func (d *Display) At(x, y int) color.Color {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return color.Gray{Y: d.layer.pixel(x, y)}
+}
+
+// Set implements draw.Image. The color is converted to grayscale, and its luminance is used as
+// the pixel value. Like SetPixel, the buffer grows as needed to fit the coordinate. The drawing
+// transform and clip rectangle are ignored.
+// Note that functions such as draw.Draw clip to Bounds, so the buffer must already be large
+// enough for the area being drawn.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) Set(x, y int, c color.Color) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.layer.growBuffer(x, y)
+	d.blendPixel(x, y, color.GrayModel.Convert(c).(color.Gray).Y, 255)
+}
+
+// Ensure the display can be used with the standard image packages.
+var _ draw.Image = &Display{}
@@ -0,0 +1,233 @@
+package scrollphathd
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// bayer4x4 is the threshold map used for ordered dithering.
+var bayer4x4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// DrawImage renders the given image into the buffer. The image can be of any size and color
+// model - it is converted to luminance, then optionally scaled to fit the target region and
+// dithered, according to the given options. Transparent pixels are treated as black.
+// The buffer grows as needed, so oversized images can be scrolled across the display. If a
+// region is given with WithImageRegion, nothing is drawn outside of it.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawImage(img image.Image, opts ...ImageOption) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	options := defaultImageOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	// An explicit region clips the image, so the rest of the buffer is left untouched
+	clipped := options.width > 0 && options.height > 0
+	region := image.Rect(options.x, options.y, options.x+options.width, options.y+options.height)
+	if options.width < 0 {
+		options.width = d.device.Width()
+	}
+	if options.height < 0 {
+		options.height = d.device.Height()
+	}
+
+	src := newLumImage(img)
+	if src.width == 0 || src.height == 0 {
+		return
+	}
+
+	// Work out which part of the source image maps to which part of the region
+	srcRect := [4]float64{0, 0, float64(src.width), float64(src.height)}
+	dstX, dstY, dstWidth, dstHeight := options.x, options.y, options.width, options.height
+	switch options.scale {
+	case ScaleNone:
+		dstWidth, dstHeight = src.width, src.height
+	case ScaleFit:
+		scale := math.Min(float64(options.width)/float64(src.width), float64(options.height)/float64(src.height))
+		dstWidth = int(float64(src.width)*scale + 0.5)
+		dstHeight = int(float64(src.height)*scale + 0.5)
+		dstX += (options.width - dstWidth) / 2
+		dstY += (options.height - dstHeight) / 2
+	case ScaleFill:
+		scale := math.Max(float64(options.width)/float64(src.width), float64(options.height)/float64(src.height))
+		cropWidth := float64(options.width) / scale
+		cropHeight := float64(options.height) / scale
+		srcRect = [4]float64{
+			(float64(src.width) - cropWidth) / 2,
+			(float64(src.height) - cropHeight) / 2,
+			cropWidth,
+			cropHeight,
+		}
+	}
+	if dstWidth <= 0 || dstHeight <= 0 {
+		return
+	}
+
+	pixels := src.resample(srcRect, dstWidth, dstHeight, options.resample)
+	dither(pixels, dstX, dstY, options.dither)
+
+	visible := image.Rect(dstX, dstY, dstX+dstWidth, dstY+dstHeight)
+	if clipped {
+		visible = visible.Intersect(region)
+	}
+	d.growRect(visible.Min.X, visible.Min.Y, visible.Dx(), visible.Dy())
+	for y, row := range pixels {
+		for x, val := range row {
+			if clipped && !(image.Point{X: dstX + x, Y: dstY + y}).In(region) {
+				continue
+			}
+			d.plot(dstX+x, dstY+y, clampByte(val))
+		}
+	}
+}
+
+// lumImage holds the luminance of each pixel of an image, from 0 to 255.
+type lumImage struct {
+	width, height int
+	pix           []float64
+}
+
+func newLumImage(img image.Image) *lumImage {
+	bounds := img.Bounds()
+	lum := &lumImage{
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		pix:    make([]float64, bounds.Dx()*bounds.Dy()),
+	}
+	for y := 0; y < lum.height; y++ {
+		for x := 0; x < lum.width; x++ {
+			c := color.GrayModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.Gray)
+			lum.pix[y*lum.width+x] = float64(c.Y)
+		}
+	}
+	return lum
+}
+
+// at returns the luminance at the given coordinate, clamped to the edges of the image.
+func (l *lumImage) at(x, y int) float64 {
+	x = clampInt(x, 0, l.width-1)
+	y = clampInt(y, 0, l.height-1)
+	return l.pix[y*l.width+x]
+}
+
+// resample scales the given rectangle of the image (x, y, width, height) to the given size.
+func (l *lumImage) resample(rect [4]float64, width, height int, resample Resample) [][]float64 {
+	scaleX, scaleY := rect[2]/float64(width), rect[3]/float64(height)
+	pixels := make([][]float64, height)
+	for y := range pixels {
+		pixels[y] = make([]float64, width)
+		for x := range pixels[y] {
+			// Bounds of the target pixel in source coordinates
+			x0, y0 := rect[0]+float64(x)*scaleX, rect[1]+float64(y)*scaleY
+			x1, y1 := x0+scaleX, y0+scaleY
+
+			switch resample {
+			case ResampleNearest:
+				pixels[y][x] = l.at(int((x0+x1)/2), int((y0+y1)/2))
+			case ResampleBilinear:
+				pixels[y][x] = l.bilinear((x0+x1)/2-0.5, (y0+y1)/2-0.5)
+			default:
+				pixels[y][x] = l.area(x0, y0, x1, y1)
+			}
+		}
+	}
+	return pixels
+}
+
+// bilinear interpolates between the four pixels surrounding the given coordinate, where pixel
+// centers sit at whole numbers.
+func (l *lumImage) bilinear(x, y float64) float64 {
+	fx, fy := math.Floor(x), math.Floor(y)
+	ix, iy := int(fx), int(fy)
+	wx, wy := x-fx, y-fy
+	top := l.at(ix, iy)*(1-wx) + l.at(ix+1, iy)*wx
+	bottom := l.at(ix, iy+1)*(1-wx) + l.at(ix+1, iy+1)*wx
+	return top*(1-wy) + bottom*wy
+}
+
+// area averages the pixels covered by the given rectangle, weighted by how much of each pixel
+// is covered.
+func (l *lumImage) area(x0, y0, x1, y1 float64) float64 {
+	total, weight := 0.0, 0.0
+	for y := int(math.Floor(y0)); float64(y) < y1; y++ {
+		wy := math.Min(y1, float64(y+1)) - math.Max(y0, float64(y))
+		for x := int(math.Floor(x0)); float64(x) < x1; x++ {
+			wx := math.Min(x1, float64(x+1)) - math.Max(x0, float64(x))
+			total += l.at(x, y) * wx * wy
+			weight += wx * wy
+		}
+	}
+	if weight == 0 {
+		return 0
+	}
+	return total / weight
+}
+
+// dither reduces the pixels to fully on or off in place. The position of the pixels in the
+// buffer is used to keep ordered patterns aligned across separate draws.
+func dither(pixels [][]float64, offsetX, offsetY int, mode Dither) {
+	for y, row := range pixels {
+		for x, val := range row {
+			var out float64
+			switch mode {
+			case DitherNone:
+				continue
+			case DitherOrdered:
+				threshold := (bayer4x4[mod(offsetY+y, 4)][mod(offsetX+x, 4)] + 0.5) * 16
+				if val >= threshold {
+					out = 255
+				}
+			default:
+				if val >= 128 {
+					out = 255
+				}
+			}
+			row[x] = out
+
+			if mode == DitherFloydSteinberg {
+				err := val - out
+				diffuse(pixels, x+1, y, err*7/16)
+				diffuse(pixels, x-1, y+1, err*3/16)
+				diffuse(pixels, x, y+1, err*5/16)
+				diffuse(pixels, x+1, y+1, err*1/16)
+			}
+		}
+	}
+}
+
+func diffuse(pixels [][]float64, x, y int, err float64) {
+	if y < len(pixels) && x >= 0 && x < len(pixels[y]) {
+		pixels[y][x] += err
+	}
+}
+
+func clampInt(val, min, max int) int {
+	if val < min {
+		return min
+	}
+	if val > max {
+		return max
+	}
+	return val
+}
+
+func clampByte(val float64) byte {
+	if val <= 0 {
+		return 0
+	}
+	if val >= 255 {
+		return 255
+	}
+	return byte(val + 0.5)
+}
+
+// mod returns the non-negative remainder of a divided by b.
+func mod(a, b int) int {
+	return ((a % b) + b) % b
+}
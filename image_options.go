@@ -0,0 +1,94 @@
+package scrollphathd
+
+// ImageOption allows specifying behavior when rendering images.
+type ImageOption func(*imageOptions)
+
+// ScaleMode specifies how an image is sized to fit its target region.
+type ScaleMode int
+
+const (
+	// ScaleNone renders the image at its native size, growing the buffer as needed.
+	ScaleNone ScaleMode = iota
+	// ScaleFit scales the image to fit entirely within the region, preserving its aspect ratio.
+	// The image is centered in the region.
+	ScaleFit
+	// ScaleFill scales the image to cover the entire region, preserving its aspect ratio. The
+	// image is centered, and any parts outside the region are cropped.
+	ScaleFill
+	// ScaleStretch scales the image to exactly the size of the region.
+	ScaleStretch
+)
+
+// Resample specifies the algorithm used when scaling an image.
+type Resample int
+
+const (
+	// ResampleArea averages all of the source pixels covered by each target pixel. This gives
+	// the smoothest results when shrinking an image.
+	ResampleArea Resample = iota
+	// ResampleNearest uses the nearest source pixel to each target pixel.
+	ResampleNearest
+	// ResampleBilinear interpolates between the four nearest source pixels.
+	ResampleBilinear
+)
+
+// Dither specifies how an image is reduced to fully on and fully off pixels.
+type Dither int
+
+const (
+	// DitherNone keeps the full range of brightness levels.
+	DitherNone Dither = iota
+	// DitherThreshold turns on pixels that are at least half brightness.
+	DitherThreshold
+	// DitherOrdered uses a 4x4 Bayer matrix to produce a regular pattern.
+	DitherOrdered
+	// DitherFloydSteinberg diffuses the error from each pixel to its neighbors.
+	DitherFloydSteinberg
+)
+
+// WithImageRegion specifies the area of the buffer that the image is rendered into. With
+// ScaleNone the image keeps its native size, and is cropped to the region - unless the width
+// or height is 0, in which case only the position is used. By default, the image is rendered
+// at the origin, and scaled to the size of the device.
+func WithImageRegion(x, y, width, height int) ImageOption {
+	return func(options *imageOptions) {
+		options.x, options.y = x, y
+		options.width, options.height = width, height
+	}
+}
+
+// WithImageScale specifies how the image is sized to fit its region (default ScaleNone).
+func WithImageScale(scale ScaleMode) ImageOption {
+	return func(options *imageOptions) {
+		options.scale = scale
+	}
+}
+
+// WithImageResample specifies the algorithm used to scale the image (default ResampleArea).
+func WithImageResample(resample Resample) ImageOption {
+	return func(options *imageOptions) {
+		options.resample = resample
+	}
+}
+
+// WithImageDither specifies how the image is reduced to on and off pixels (default DitherNone).
+func WithImageDither(dither Dither) ImageOption {
+	return func(options *imageOptions) {
+		options.dither = dither
+	}
+}
+
+type imageOptions struct {
+	x, y, width, height int
+	scale               ScaleMode
+	resample            Resample
+	dither              Dither
+}
+
+var defaultImageOptions = imageOptions{
+	width:    -1,
+	height:   -1,
+	scale:    ScaleNone,
+	resample: ResampleArea,
+	dither:   DitherNone,
+}
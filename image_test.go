@@ -0,0 +1,190 @@
+package scrollphathd_test
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_Image(t *testing.T) {
+	dev, disp := getDisplay()
+	src := image.NewUniform(color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	draw.Draw(disp, image.Rect(1, 0, 2, 2), src, image.Point{}, draw.Src)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 255, 0},
+		{0, 255, 0},
+		{0, 0, 0},
+	})
+
+	disp.SetPixel(4, 5, 1)
+	if bounds := disp.Bounds(); bounds != image.Rect(0, 0, 5, 6) {
+		t.Fatalf("bounds were %v, expected buffer to grow", bounds)
+	}
+	if val := disp.At(4, 5).(color.Gray).Y; val != 1 {
+		t.Fatalf("value at (4, 5) was %d, expected 1", val)
+	}
+}
+
+func TestDisplay_DrawImageScaled(t *testing.T) {
+	// 6x3 image with a bright left half, and a dim right half
+	src := image.NewGray(image.Rect(0, 0, 6, 3))
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 6; x++ {
+			val := uint8(200)
+			if x >= 3 {
+				val = 50
+			}
+			src.SetGray(x, y, color.Gray{Y: val})
+		}
+	}
+
+	// Fit shrinks to 3x2 (rounded), centered vertically
+	dev, disp := getDisplay()
+	disp.DrawImage(src, scrollphathd.WithImageScale(scrollphathd.ScaleFit))
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{200, 125, 50},
+		{200, 125, 50},
+		{0, 0, 0},
+	})
+
+	// Native size grows the buffer
+	dev, disp = getDisplay()
+	disp.DrawImage(src, scrollphathd.WithImageDither(scrollphathd.DitherThreshold))
+	if bounds := disp.Bounds(); bounds.Dx() != 6 {
+		t.Fatalf("bounds were %v, expected buffer to grow to fit image", bounds)
+	}
+	disp.ScrollTo(2, 0)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{255, 0, 0},
+		{255, 0, 0},
+		{255, 0, 0},
+	})
+}
+
+func TestDisplay_DrawImageModes(t *testing.T) {
+	gray := func(rows [][]uint8) image.Image {
+		img := image.NewGray(image.Rect(0, 0, len(rows[0]), len(rows)))
+		for y, row := range rows {
+			for x, val := range row {
+				img.SetGray(x, y, color.Gray{Y: val})
+			}
+		}
+		return img
+	}
+	uniform := gray([][]uint8{{128, 128, 128}, {128, 128, 128}, {128, 128, 128}})
+	dim := gray([][]uint8{{64, 64, 64}, {64, 64, 64}, {64, 64, 64}})
+	corners := gray([][]uint8{{0, 100}, {200, 240}})
+	gradient := gray([][]uint8{
+		{0, 40, 80, 120, 160, 200},
+		{0, 40, 80, 120, 160, 200},
+		{0, 40, 80, 120, 160, 200},
+	})
+
+	testCases := []struct {
+		name     string
+		img      image.Image
+		opts     []scrollphathd.ImageOption
+		expected [][]byte
+	}{
+		{
+			name: "ordered dither",
+			img:  uniform,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageDither(scrollphathd.DitherOrdered)},
+			expected: [][]byte{
+				{255, 0, 255},
+				{0, 255, 0},
+				{255, 0, 255},
+			},
+		},
+		{
+			name: "ordered dither dim",
+			img:  dim,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageDither(scrollphathd.DitherOrdered)},
+			expected: [][]byte{
+				{255, 0, 255},
+				{0, 0, 0},
+				{255, 0, 255},
+			},
+		},
+		{
+			name: "floyd-steinberg dither",
+			img:  uniform,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageDither(scrollphathd.DitherFloydSteinberg)},
+			expected: [][]byte{
+				{255, 0, 255},
+				{0, 255, 0},
+				{255, 0, 255},
+			},
+		},
+		{
+			name: "floyd-steinberg dither dim",
+			img:  dim,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageDither(scrollphathd.DitherFloydSteinberg)},
+			expected: [][]byte{
+				{0, 0, 0},
+				{0, 255, 0},
+				{0, 0, 0},
+			},
+		},
+		{
+			name: "nearest resample",
+			img:  corners,
+			opts: []scrollphathd.ImageOption{
+				scrollphathd.WithImageScale(scrollphathd.ScaleStretch),
+				scrollphathd.WithImageResample(scrollphathd.ResampleNearest),
+			},
+			expected: [][]byte{
+				{0, 100, 100},
+				{200, 240, 240},
+				{200, 240, 240},
+			},
+		},
+		{
+			name: "bilinear resample",
+			img:  corners,
+			opts: []scrollphathd.ImageOption{
+				scrollphathd.WithImageScale(scrollphathd.ScaleStretch),
+				scrollphathd.WithImageResample(scrollphathd.ResampleBilinear),
+			},
+			expected: [][]byte{
+				{0, 50, 100},
+				{100, 135, 170},
+				{200, 220, 240},
+			},
+		},
+		{
+			name: "fill",
+			img:  gradient,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageScale(scrollphathd.ScaleFill)},
+			expected: [][]byte{
+				{60, 100, 140},
+				{60, 100, 140},
+				{60, 100, 140},
+			},
+		},
+		{
+			name: "stretch",
+			img:  gradient,
+			opts: []scrollphathd.ImageOption{scrollphathd.WithImageScale(scrollphathd.ScaleStretch)},
+			expected: [][]byte{
+				{20, 100, 180},
+				{20, 100, 180},
+				{20, 100, 180},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dev, disp := getDisplay()
+			disp.DrawImage(tc.img, tc.opts...)
+			disp.Show()
+			dev.checkPixels(t, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package scrollphathd
+
+import (
+	"fmt"
+	"image"
+	"sort"
+)
+
+// BaseLayer is the name of the layer that every Display starts with. It sits at z-order 0, and
+// cannot be removed.
+const BaseLayer = "base"
+
+// Layer is a buffer that is composited with the other layers of a Display on Show. Each layer
+// grows independently, and has its own scroll offset, flipping, opacity and z-order, so that
+// different elements on the display can scroll independently of each other.
+// Draw onto a layer by selecting it with Display.SelectLayer.
+type Layer struct {
+	display *Display
+	name    string
+	buffer  [][]byte
+	width, height,
+	scrollX, scrollY int
+
+	// The buffer can grow in all directions. The origin is the buffer index of coordinate
+	// (0, 0), so that negative coordinates can be stored
+	originX, originY int
+
+	flipX, flipY bool
+	opacity      byte
+	z            int
+	hidden       bool
+	tile         bool
+	sprites      []*Sprite
+
+	// viewport is the area of the device that the layer is displayed in, or empty for the
+	// whole device
+	viewport image.Rectangle
+}
+
+// AddLayer adds a new layer with the given name to the display, at the given z-order. Layers
+// with a higher z-order are drawn on top. Layers with the same z-order are drawn in the order
+// they were added.
+func (d *Display) AddLayer(name string, z int) (*Layer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.findLayer(name) != nil {
+		return nil, fmt.Errorf("layer %q already exists", name)
+	}
+	layer := d.newLayer(name, z)
+	d.layers = append(d.layers, layer)
+	d.sortLayers()
+	return layer, nil
+}
+
+// AddViewport adds a new layer with the given name to the display, at the given z-order, which
+// is only displayed within the given area of the device. This allows splitting the device into
+// areas that scroll independently, such as a fixed icon next to a scrolling ticker. The layer's
+// buffer starts out the same size as the viewport. See Layer.SetViewport.
+func (d *Display) AddViewport(name string, z int, x, y, width, height int) (*Layer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.findLayer(name) != nil {
+		return nil, fmt.Errorf("layer %q already exists", name)
+	}
+	layer := d.newLayer(name, z)
+	if err := layer.setViewport(x, y, width, height); err != nil {
+		return nil, err
+	}
+	layer.resetBuffer()
+	d.layers = append(d.layers, layer)
+	d.sortLayers()
+	return layer, nil
+}
+
+// Layer returns the layer with the given name, or nil if there is no such layer.
+func (d *Display) Layer(name string) *Layer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.findLayer(name)
+}
+
+func (d *Display) findLayer(name string) *Layer {
+	for _, layer := range d.layers {
+		if layer.name == name {
+			return layer
+		}
+	}
+	return nil
+}
+
+// RemoveLayer removes the layer with the given name from the display, along with its sprites.
+// If the layer was selected, the base layer is selected instead.
+func (d *Display) RemoveLayer(name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if name == BaseLayer {
+		return fmt.Errorf("cannot remove the base layer")
+	}
+	for i, layer := range d.layers {
+		if layer.name == name {
+			d.layers = append(d.layers[:i], d.layers[i+1:]...)
+			for _, s := range layer.sprites {
+				s.setLayer(nil)
+			}
+			if d.layer == layer {
+				d.layer = d.findLayer(BaseLayer)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("layer %q does not exist", name)
+}
+
+// SelectLayer selects the layer with the given name as the target for subsequent drawing,
+// scrolling and flipping operations on the display.
+func (d *Display) SelectLayer(name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	layer := d.findLayer(name)
+	if layer == nil {
+		return fmt.Errorf("layer %q does not exist", name)
+	}
+	d.layer = layer
+	return nil
+}
+
+func (d *Display) newLayer(name string, z int) *Layer {
+	l := &Layer{
+		display: d,
+		name:    name,
+		opacity: 255,
+		z:       z,
+		tile:    d.options.tile,
+	}
+	l.resetBuffer()
+	return l
+}
+
+func (d *Display) sortLayers() {
+	sort.SliceStable(d.layers, func(i, j int) bool {
+		return d.layers[i].z < d.layers[j].z
+	})
+}
+
+// Name returns the name of the layer.
+func (l *Layer) Name() string {
+	return l.name
+}
+
+// SetFlip configures flipping for the layer.
+func (l *Layer) SetFlip(flipX, flipY bool) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.flipX = flipX
+	l.flipY = flipY
+}
+
+// ScrollTo configures the top left coordinate to use from the layer's buffer for display.
+// Coordinates are unaffected by the buffer growing left or up, and may be negative to show
+// content drawn at negative coordinates. When tiling, the buffer wraps in both directions.
+func (l *Layer) ScrollTo(scrollX, scrollY int) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.scrollX = scrollX
+	l.scrollY = scrollY
+}
+
+// Scroll scrolls the layer's buffer relative to its current position.
+func (l *Layer) Scroll(deltaX, deltaY int) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.scrollX += deltaX
+	l.scrollY += deltaY
+}
+
+// SetOpacity configures how strongly the layer's lit pixels cover the layers below it.
+// 0 is fully transparent, 255 is fully opaque. Unlit pixels are always transparent.
+func (l *Layer) SetOpacity(opacity byte) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.opacity = opacity
+}
+
+// SetZ configures the z-order of the layer. Layers with a higher z-order are drawn on top.
+func (l *Layer) SetZ(z int) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.z = z
+	l.display.sortLayers()
+}
+
+// SetVisible configures whether the layer is composited on Show.
+func (l *Layer) SetVisible(visible bool) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.hidden = !visible
+}
+
+// SetTiling configures whether the layer's buffer tiles when scrolling. Defaults to the
+// display's WithTiling option.
+func (l *Layer) SetTiling(tile bool) {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	l.tile = tile
+}
+
+// SetViewport restricts the layer to the given area of the device. The top left corner of the
+// viewport displays the layer's scroll position, so drawing and scrolling work the same as for
+// a layer covering the whole device. The buffer is not resized. The width and height must be at
+// least 1.
+func (l *Layer) SetViewport(x, y, width, height int) error {
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	return l.setViewport(x, y, width, height)
+}
+
+func (l *Layer) setViewport(x, y, width, height int) error {
+	if width < 1 || height < 1 {
+		return fmt.Errorf("received invalid viewport size %dx%d - must be at least 1x1", width, height)
+	}
+	l.viewport = image.Rect(x, y, x+width, y+height)
+	return nil
+}
+
+// viewportPixel maps the given device coordinate into the layer's viewport, returning false if
+// it is outside of the viewport.
+func (l *Layer) viewportPixel(devX, devY int) (int, int, bool) {
+	if l.viewport.Empty() {
+		return devX, devY, true
+	}
+	if !(image.Point{X: devX, Y: devY}).In(l.viewport) {
+		return 0, 0, false
+	}
+	return devX - l.viewport.Min.X, devY - l.viewport.Min.Y, true
+}
+
+// getSourcePixel returns the value from the layer's buffer that should be displayed at the
+// given device coordinate, after scrolling and flipping are applied.
+func (l *Layer) getSourcePixel(devX, devY int) byte {
+	x := devX
+	x += l.scrollX + l.originX
+	if l.tile {
+		x = mod(x, l.width)
+	}
+	if l.flipX {
+		x = l.width - x - 1
+	}
+
+	// Fail early if x is nonsense
+	if x < 0 || x >= l.width {
+		return 0
+	}
+
+	y := devY
+	y += l.scrollY + l.originY
+	if l.tile {
+		y = mod(y, l.height)
+	}
+	if l.flipY {
+		y = l.height - y - 1
+	}
+
+	if y < 0 || y >= l.height {
+		return 0
+	}
+
+	// Sprites are drawn over the buffer, with the most recently added on top
+	for i := len(l.sprites) - 1; i >= 0; i-- {
+		if val, ok := l.sprites[i].pixelAt(x-l.originX, y-l.originY); ok {
+			return val
+		}
+	}
+	return l.buffer[y][x]
+}
@@ -0,0 +1,117 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_Layers(t *testing.T) {
+	dev, disp := getDisplay(scrollphathd.WithTiling(false))
+	disp.Fill(0, 0, 3, 1, 10)
+
+	ticker, err := disp.AddLayer("ticker", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := disp.AddLayer("ticker", 2); err == nil {
+		t.Fatal("expected error adding duplicate layer")
+	}
+	if err := disp.SelectLayer("ticker"); err != nil {
+		t.Fatal(err)
+	}
+	disp.SetPixel(1, 0, 200)
+	disp.SetPixel(2, 1, 100)
+
+	// The ticker scrolls independently, and its unlit pixels let the base layer show through
+	ticker.ScrollTo(1, 0)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{200, 10, 10},
+		{0, 100, 0},
+		{0, 0, 0},
+	})
+
+	ticker.SetOpacity(128)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{105, 10, 10},
+		{0, 50, 0},
+		{0, 0, 0},
+	})
+
+	// Moving the ticker below the base layer hides it where they overlap
+	ticker.SetOpacity(255)
+	ticker.SetZ(-1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{10, 10, 10},
+		{0, 100, 0},
+		{0, 0, 0},
+	})
+
+	if err := disp.RemoveLayer("ticker"); err != nil {
+		t.Fatal(err)
+	}
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{10, 10, 10},
+		{0, 0, 0},
+		{0, 0, 0},
+	})
+	if err := disp.RemoveLayer(scrollphathd.BaseLayer); err == nil {
+		t.Fatal("expected error removing base layer")
+	}
+}
+
+func TestDisplay_Viewports(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.SetPixel(0, 0, 1)
+
+	// A fixed icon on the left, and a scrolling ticker on the right
+	ticker, err := disp.AddViewport("ticker", 1, 1, 0, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := disp.SelectLayer("ticker"); err != nil {
+		t.Fatal(err)
+	}
+	disp.SetPixel(0, 1, 2)
+	disp.SetPixel(2, 2, 3)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 0, 0},
+		{0, 2, 0},
+		{0, 0, 0},
+	})
+
+	// The ticker's buffer grew to 3 wide, so it tiles at that width
+	ticker.ScrollTo(2, 0)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 0, 0},
+		{0, 0, 2},
+		{0, 3, 0},
+	})
+
+	ticker.SetTiling(false)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 0, 0},
+		{0, 0, 0},
+		{0, 3, 0},
+	})
+}
+
+func TestDisplay_ViewportsInvalid(t *testing.T) {
+	_, disp := getDisplay()
+	if _, err := disp.AddViewport("empty", 1, 1, 1, 0, 3); err == nil {
+		t.Fatal("expected error adding zero width viewport")
+	}
+	if disp.Layer("empty") != nil {
+		t.Fatal("expected invalid viewport not to be added")
+	}
+	if err := disp.Layer(scrollphathd.BaseLayer).SetViewport(0, 0, 3, 0); err == nil {
+		t.Fatal("expected error setting zero height viewport")
+	}
+}
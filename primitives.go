@@ -0,0 +1,383 @@
+package scrollphathd
+
+import (
+	"image"
+	"math"
+	"sort"
+)
+
+// DrawLine draws a line between the given coordinates, inclusive, using Bresenham's algorithm.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawLine(x0, y0, x1, y1 int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(linePixels(pixelSet{}, x0, y0, x1, y1), val)
+}
+
+// DrawLineAA draws an anti-aliased line between the given coordinates, using Xiaolin Wu's
+// algorithm. Pixels are lit in proportion to how much of them the line covers, so coordinates
+// may be fractional. With BlendAlpha, the coverage scales the alpha instead.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawLineAA(x0, y0, x1, y1 float64, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	coverage := map[image.Point]float64{}
+	plot := func(x, y int, c float64) {
+		p := image.Point{X: x, Y: y}
+		if c > coverage[p] {
+			coverage[p] = c
+		}
+	}
+
+	steep := math.Abs(y1-y0) > math.Abs(x1-x0)
+	if steep {
+		x0, y0, x1, y1 = y0, x0, y1, x1
+		inner := plot
+		plot = func(x, y int, c float64) { inner(y, x, c) }
+	}
+	if x0 > x1 {
+		x0, y0, x1, y1 = x1, y1, x0, y0
+	}
+
+	gradient := 1.0
+	if dx := x1 - x0; dx != 0 {
+		gradient = (y1 - y0) / dx
+	}
+
+	// Endpoints are weighted by how much of their pixel the line covers horizontally
+	xStart, xEnd := math.Floor(x0+0.5), math.Floor(x1+0.5)
+	startGap := 1 - (x0 + 0.5 - xStart)
+	endGap := x1 + 0.5 - xEnd
+	for x := xStart; x <= xEnd; x++ {
+		weight := 1.0
+		if x == xStart {
+			weight = startGap
+		} else if x == xEnd {
+			weight = endGap
+		}
+		y := y0 + gradient*(x-x0)
+		fy := math.Floor(y)
+		plot(int(x), int(fy), (1-(y-fy))*weight)
+		plot(int(x), int(fy)+1, (y-fy)*weight)
+	}
+
+	for p, c := range coverage {
+		if c > 0 {
+			d.plotCoverage(p.X, p.Y, val, byte(c*255+0.5))
+		}
+	}
+}
+
+// DrawRect draws the outline of the given rectangle.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawRect(x, y, width, height int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(roundedRectPixels(x, y, width, height, 0), val)
+}
+
+// DrawRoundedRect draws the outline of the given rectangle, with corners rounded to the given
+// radius.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawRoundedRect(x, y, width, height, radius int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(roundedRectPixels(x, y, width, height, radius), val)
+}
+
+// FillRoundedRect fills the given rectangle, with corners rounded to the given radius.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillRoundedRect(x, y, width, height, radius int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(fillRows(roundedRectPixels(x, y, width, height, radius)), val)
+}
+
+// DrawCircle draws the outline of a circle centered on the given coordinate.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawCircle(cx, cy, radius int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(ellipsePixels(cx, cy, radius, radius), val)
+}
+
+// FillCircle fills a circle centered on the given coordinate.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillCircle(cx, cy, radius int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(fillRows(ellipsePixels(cx, cy, radius, radius)), val)
+}
+
+// DrawEllipse draws the outline of an ellipse centered on the given coordinate, with the given
+// horizontal and vertical radii.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawEllipse(cx, cy, rx, ry int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(ellipsePixels(cx, cy, rx, ry), val)
+}
+
+// FillEllipse fills an ellipse centered on the given coordinate, with the given horizontal and
+// vertical radii.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillEllipse(cx, cy, rx, ry int, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(fillRows(ellipsePixels(cx, cy, rx, ry)), val)
+}
+
+// DrawArc draws part of the outline of a circle centered on the given coordinate. Angles are in
+// radians, measured clockwise from the positive x axis (since y increases downward), and the arc
+// is drawn clockwise from start to end.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawArc(cx, cy, radius int, start, end float64, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	full := end-start >= 2*math.Pi
+	sweep := wrapAngle(end - start)
+	arc := pixelSet{}
+	for p := range ellipsePixels(cx, cy, radius, radius) {
+		angle := math.Atan2(float64(p.Y-cy), float64(p.X-cx))
+		if full || wrapAngle(angle-start) <= sweep {
+			arc[p] = struct{}{}
+		}
+	}
+	d.drawSet(arc, val)
+}
+
+// DrawPolygon draws the outline of the polygon with the given vertices. The polygon is closed
+// automatically.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) DrawPolygon(points []image.Point, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.drawSet(polygonPixels(points), val)
+}
+
+// FillPolygon fills the polygon with the given vertices, using the even-odd rule. The polygon
+// is closed automatically, and may be concave or self-intersecting.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) FillPolygon(points []image.Point, val byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	pixels := polygonPixels(points)
+	if len(points) < 3 {
+		d.drawSet(pixels, val)
+		return
+	}
+
+	minY, maxY := points[0].Y, points[0].Y
+	for _, p := range points {
+		minY, maxY = minInt(minY, p.Y), maxInt(maxY, p.Y)
+	}
+	for y := minY; y <= maxY; y++ {
+		// Find where each edge crosses this row, counting the top of each edge but not the
+		// bottom so that shared vertices aren't counted twice
+		var crossings []float64
+		for i, p0 := range points {
+			p1 := points[(i+1)%len(points)]
+			if (p0.Y <= y && y < p1.Y) || (p1.Y <= y && y < p0.Y) {
+				t := float64(y-p0.Y) / float64(p1.Y-p0.Y)
+				crossings = append(crossings, float64(p0.X)+t*float64(p1.X-p0.X))
+			}
+		}
+		sort.Float64s(crossings)
+		for i := 0; i+1 < len(crossings); i += 2 {
+			for x := int(math.Ceil(crossings[i])); x <= int(math.Floor(crossings[i+1])); x++ {
+				pixels.add(x, y)
+			}
+		}
+	}
+	d.drawSet(pixels, val)
+}
+
+// wrapAngle returns the given angle in radians, wrapped into the range [0, 2π).
+func wrapAngle(angle float64) float64 {
+	return math.Mod(math.Mod(angle, 2*math.Pi)+2*math.Pi, 2*math.Pi)
+}
+
+// pixelSet collects the pixels of a shape, so that each pixel is only drawn once.
+type pixelSet map[image.Point]struct{}
+
+func (s pixelSet) add(x, y int) {
+	s[image.Point{X: x, Y: y}] = struct{}{}
+}
+
+// drawSet draws all of the pixels in the given set.
+func (d *Display) drawSet(pixels pixelSet, val byte) {
+	for p := range pixels {
+		d.plot(p.X, p.Y, val)
+	}
+}
+
+// fillRows fills in each row of the given outline between its leftmost and rightmost pixels.
+// This is only suitable for shapes where each row is a single span, such as ellipses.
+func fillRows(outline pixelSet) pixelSet {
+	spans := map[int][2]int{}
+	for p := range outline {
+		span, ok := spans[p.Y]
+		if !ok {
+			span = [2]int{p.X, p.X}
+		}
+		spans[p.Y] = [2]int{minInt(span[0], p.X), maxInt(span[1], p.X)}
+	}
+
+	filled := pixelSet{}
+	for y, span := range spans {
+		for x := span[0]; x <= span[1]; x++ {
+			filled.add(x, y)
+		}
+	}
+	return filled
+}
+
+// linePixels adds the pixels of the line between the given coordinates to the set, using
+// Bresenham's algorithm.
+func linePixels(pixels pixelSet, x0, y0, x1, y1 int) pixelSet {
+	dx, dy := absInt(x1-x0), -absInt(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		pixels.add(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return pixels
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// polygonPixels returns the outline of the polygon with the given vertices.
+func polygonPixels(points []image.Point) pixelSet {
+	pixels := pixelSet{}
+	for i, p0 := range points {
+		p1 := points[(i+1)%len(points)]
+		linePixels(pixels, p0.X, p0.Y, p1.X, p1.Y)
+	}
+	return pixels
+}
+
+// ellipsePixels returns the outline of an ellipse, using the midpoint algorithm.
+func ellipsePixels(cx, cy, rx, ry int) pixelSet {
+	pixels := pixelSet{}
+	if rx < 0 || ry < 0 {
+		return pixels
+	}
+	quadrantPoints(rx, ry, func(x, y int) {
+		pixels.add(cx+x, cy+y)
+		pixels.add(cx-x, cy+y)
+		pixels.add(cx+x, cy-y)
+		pixels.add(cx-x, cy-y)
+	})
+	return pixels
+}
+
+// roundedRectPixels returns the outline of a rectangle with rounded corners. The radius is
+// limited to half of the smallest side.
+func roundedRectPixels(x, y, width, height, radius int) pixelSet {
+	pixels := pixelSet{}
+	if width <= 0 || height <= 0 {
+		return pixels
+	}
+	radius = maxInt(0, minInt(radius, minInt(width-1, height-1)/2))
+
+	left, top := x+radius, y+radius
+	right, bottom := x+width-1-radius, y+height-1-radius
+	linePixels(pixels, left, y, right, y)
+	linePixels(pixels, left, y+height-1, right, y+height-1)
+	linePixels(pixels, x, top, x, bottom)
+	linePixels(pixels, x+width-1, top, x+width-1, bottom)
+	quadrantPoints(radius, radius, func(qx, qy int) {
+		pixels.add(left-qx, top-qy)
+		pixels.add(right+qx, top-qy)
+		pixels.add(left-qx, bottom+qy)
+		pixels.add(right+qx, bottom+qy)
+	})
+	return pixels
+}
+
+// quadrantPoints calls the given function with the points of one quadrant of an ellipse with
+// the given radii, centered on the origin, using the midpoint algorithm.
+func quadrantPoints(rx, ry int, fn func(x, y int)) {
+	if rx == 0 || ry == 0 {
+		// Degenerate ellipses are straight lines
+		for x := 0; x <= rx; x++ {
+			fn(x, 0)
+		}
+		for y := 0; y <= ry; y++ {
+			fn(0, y)
+		}
+		return
+	}
+
+	rx2, ry2 := float64(rx*rx), float64(ry*ry)
+	x, y := 0, ry
+	px, py := 0.0, 2*rx2*float64(y)
+
+	// Region 1, where the slope is shallower than -1
+	p := ry2 - rx2*float64(ry) + rx2/4
+	for px < py {
+		fn(x, y)
+		x++
+		px += 2 * ry2
+		if p < 0 {
+			p += ry2 + px
+		} else {
+			y--
+			py -= 2 * rx2
+			p += ry2 + px - py
+		}
+	}
+
+	// Region 2, where the slope is steeper than -1
+	fx, fy := float64(x)+0.5, float64(y-1)
+	p = ry2*fx*fx + rx2*fy*fy - rx2*ry2
+	for y >= 0 {
+		fn(x, y)
+		y--
+		py -= 2 * rx2
+		if p > 0 {
+			p += rx2 - py
+		} else {
+			x++
+			px += 2 * ry2
+			p += rx2 - py + px
+		}
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
@@ -0,0 +1,143 @@
+package scrollphathd_test
+
+import (
+	"image"
+	"math"
+	"testing"
+)
+
+func TestDisplay_DrawLine(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.DrawLine(0, 2, 2, 0, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 1},
+		{0, 1, 0},
+		{1, 0, 0},
+	})
+
+	// Anti-aliased lines split their coverage between pixels, with endpoints weighted by how
+	// much of their pixel is covered
+	dev, disp = getDisplay()
+	disp.DrawLineAA(0, 0.5, 2, 0.5, 200)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{50, 100, 50},
+		{50, 100, 50},
+		{0, 0, 0},
+	})
+}
+
+func TestDisplay_DrawShapes(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.DrawRect(0, 0, 3, 3, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 1, 1},
+		{1, 0, 1},
+		{1, 1, 1},
+	})
+
+	dev, disp = getDisplay()
+	disp.DrawCircle(1, 1, 1, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 1, 0},
+		{1, 0, 1},
+		{0, 1, 0},
+	})
+
+	// Shapes can extend past the edge of the buffer
+	dev, disp = getDisplay()
+	disp.FillCircle(0, 0, 2, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 1, 1},
+		{1, 1, 1},
+		{1, 1, 0},
+	})
+
+	dev, disp = getDisplay()
+	disp.FillPolygon([]image.Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 0, Y: 2}}, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 1, 1},
+		{1, 1, 0},
+		{1, 0, 0},
+	})
+}
+
+func TestDisplay_DrawEllipse(t *testing.T) {
+	_, disp := getDisplay()
+	disp.DrawEllipse(3, 2, 3, 2, 1)
+	checkBuffer(t, disp, [][]byte{
+		{0, 0, 1, 1, 1, 0, 0},
+		{0, 1, 0, 0, 0, 1, 0},
+		{1, 0, 0, 0, 0, 0, 1},
+		{0, 1, 0, 0, 0, 1, 0},
+		{0, 0, 1, 1, 1, 0, 0},
+	})
+}
+
+func TestDisplay_RoundedRect(t *testing.T) {
+	_, disp := getDisplay()
+	disp.DrawRoundedRect(0, 0, 6, 5, 2, 1)
+	checkBuffer(t, disp, [][]byte{
+		{0, 1, 1, 1, 1, 0},
+		{1, 0, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0, 1},
+		{0, 1, 1, 1, 1, 0},
+	})
+
+	_, disp = getDisplay()
+	disp.FillRoundedRect(0, 0, 6, 5, 2, 1)
+	checkBuffer(t, disp, [][]byte{
+		{0, 1, 1, 1, 1, 0},
+		{1, 1, 1, 1, 1, 1},
+		{1, 1, 1, 1, 1, 1},
+		{1, 1, 1, 1, 1, 1},
+		{0, 1, 1, 1, 1, 0},
+	})
+}
+
+func TestDisplay_DrawArc(t *testing.T) {
+	circle := [][]byte{
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 1, 1, 1, 0, 0, 0},
+		{0, 0, 1, 0, 0, 0, 1, 0, 0},
+		{0, 1, 0, 0, 0, 0, 0, 1, 0},
+		{0, 1, 0, 0, 0, 0, 0, 1, 0},
+		{0, 1, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 1, 0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 1, 1, 1, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+	}
+	rightHalf := [][]byte{
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 1, 1, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 0, 0, 0, 0, 0, 1, 0, 0},
+		{0, 0, 0, 0, 1, 1, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+	}
+	testCases := []struct {
+		name       string
+		start, end float64
+		expected   [][]byte
+	}{
+		{name: "half", start: -math.Pi / 2, end: math.Pi / 2, expected: rightHalf},
+		{name: "wraps past zero", start: 3 * math.Pi / 2, end: math.Pi / 2, expected: rightHalf},
+		{name: "full circle", start: 1, end: 1 + 2*math.Pi, expected: circle},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, disp := getDisplay()
+			disp.DrawArc(4, 4, 3, tc.start, tc.end, 1)
+			checkBuffer(t, disp, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package scrollphathd
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"unicode/utf8"
+)
+
+const (
+	psf1Magic0 = 0x36
+	psf1Magic1 = 0x04
+
+	psf1Mode512    = 0x01
+	psf1ModeHasTab = 0x02
+	psf1Separator  = 0xffff
+	psf1StartSeq   = 0xfffe
+
+	// psfMaxGlyphs guards against malformed headers allocating huge amounts of memory
+	psfMaxGlyphs = 1 << 16
+
+	psf2HasUnicodeTable = 0x01
+	psf2Separator       = 0xff
+	psf2StartSeq        = 0xfe
+)
+
+var psf2Magic = []byte{0x72, 0xb5, 0x4a, 0x86}
+
+// LoadPSF parses a Linux console font in either the PSF1 or PSF2 format. If the font includes a
+// unicode table it is used to map runes to glyphs; otherwise glyphs are mapped by their index.
+// PSF fonts don't record a baseline, so it is placed at the bottom of each glyph.
+// Use Smoothed or Downsampled on the result for antialiased variants of the font.
+func LoadPSF(r io.Reader) (*BitmapFont, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(data) >= 4 && bytes.Equal(data[:4], psf2Magic):
+		return loadPSF2(data)
+	case len(data) >= 4 && data[0] == psf1Magic0 && data[1] == psf1Magic1:
+		return loadPSF1(data)
+	default:
+		return nil, fmt.Errorf("received invalid PSF font: unrecognized header")
+	}
+}
+
+func loadPSF1(data []byte) (*BitmapFont, error) {
+	mode, height := data[2], int(data[3])
+	if height == 0 {
+		return nil, fmt.Errorf("received invalid PSF font: glyph height 0")
+	}
+	count := 256
+	if mode&psf1Mode512 != 0 {
+		count = 512
+	}
+
+	data = data[4:]
+	if len(data) < count*height {
+		return nil, fmt.Errorf("received invalid PSF font: expected %d glyphs", count)
+	}
+	bitmaps := psfBitmaps(data, count, 8, height)
+
+	var glyphs map[rune]Glyph
+	if mode&psf1ModeHasTab != 0 {
+		glyphs = psf1UnicodeGlyphs(data[count*height:], bitmaps)
+	} else {
+		glyphs = psfIndexGlyphs(bitmaps)
+	}
+	return NewBitmapFont(glyphs, height, height), nil
+}
+
+// psf1UnicodeGlyphs maps runes to glyphs using a PSF1 unicode table, which holds a list of
+// UCS-2 values for each glyph.
+func psf1UnicodeGlyphs(table []byte, bitmaps []Glyph) map[rune]Glyph {
+	glyphs := map[rune]Glyph{}
+	for idx := 0; idx < len(bitmaps) && len(table) >= 2; idx++ {
+		inSeq := false
+		for len(table) >= 2 {
+			val := binary.LittleEndian.Uint16(table)
+			table = table[2:]
+			if val == psf1Separator {
+				break
+			}
+			if val == psf1StartSeq {
+				// Multi-rune sequences can't be represented, so ignore the rest of the entry
+				inSeq = true
+			}
+			if !inSeq {
+				glyphs[rune(val)] = bitmaps[idx]
+			}
+		}
+	}
+	return glyphs
+}
+
+// psf2Header holds the fields of a PSF2 header that are needed to decode the font.
+type psf2Header struct {
+	headerSize, flags, count, charSize, height, width int
+}
+
+func loadPSF2(data []byte) (*BitmapFont, error) {
+	header, err := parsePSF2Header(data)
+	if err != nil {
+		return nil, err
+	}
+
+	data = data[header.headerSize:]
+	bitmaps := psfBitmaps(data, header.count, header.width, header.height)
+
+	var glyphs map[rune]Glyph
+	if header.flags&psf2HasUnicodeTable != 0 {
+		glyphs = psf2UnicodeGlyphs(data[header.count*header.charSize:], bitmaps)
+	} else {
+		glyphs = psfIndexGlyphs(bitmaps)
+	}
+	return NewBitmapFont(glyphs, header.height, header.height), nil
+}
+
+// parsePSF2Header decodes and validates a PSF2 header, including that the data is long enough
+// to hold all of the glyphs.
+func parsePSF2Header(data []byte) (psf2Header, error) {
+	if len(data) < 32 {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: header too short")
+	}
+	// The fields are validated as uint32 before converting them, so that they can't overflow int
+	// on 32-bit platforms
+	fields := make([]uint32, 7)
+	for i := range fields {
+		fields[i] = binary.LittleEndian.Uint32(data[4+i*4:])
+	}
+	headerSize, flags, count, charSize, height, width := fields[1], fields[2], fields[3], fields[4], fields[5], fields[6]
+	if width == 0 || height == 0 || width > maxGlyphSize || height > maxGlyphSize {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: glyph size %dx%d", width, height)
+	}
+	if count == 0 || count > psfMaxGlyphs {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: glyph count %d", count)
+	}
+	if charSize != (width+7)/8*height {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: glyph size %d doesn't match %dx%d", charSize, width, height)
+	}
+	if headerSize < 32 || uint64(headerSize) > uint64(len(data)) {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: header size %d", headerSize)
+	}
+	if uint64(count)*uint64(charSize) > uint64(len(data))-uint64(headerSize) {
+		return psf2Header{}, fmt.Errorf("received invalid PSF font: expected %d glyphs", count)
+	}
+	header := psf2Header{
+		headerSize: int(headerSize),
+		flags:      int(flags),
+		count:      int(count),
+		charSize:   int(charSize),
+		height:     int(height),
+		width:      int(width),
+	}
+	return header, nil
+}
+
+// psf2UnicodeGlyphs maps runes to glyphs using a PSF2 unicode table, which holds a list of UTF-8
+// encoded runes for each glyph.
+func psf2UnicodeGlyphs(table []byte, bitmaps []Glyph) map[rune]Glyph {
+	glyphs := map[rune]Glyph{}
+	for idx := 0; idx < len(bitmaps) && len(table) > 0; idx++ {
+		end := bytes.IndexByte(table, psf2Separator)
+		if end < 0 {
+			end = len(table)
+		}
+		entry := table[:end]
+		if seq := bytes.IndexByte(entry, psf2StartSeq); seq >= 0 {
+			// Multi-rune sequences can't be represented, so ignore them
+			entry = entry[:seq]
+		}
+		for len(entry) > 0 {
+			r, size := utf8.DecodeRune(entry)
+			if r != utf8.RuneError {
+				glyphs[r] = bitmaps[idx]
+			}
+			entry = entry[size:]
+		}
+		if end < len(table) {
+			end++
+		}
+		table = table[end:]
+	}
+	return glyphs
+}
+
+// psfBitmaps decodes the given number of glyph bitmaps. Each row is padded to a whole byte.
+func psfBitmaps(data []byte, count, width, height int) []Glyph {
+	rowSize := (width + 7) / 8
+	glyphs := make([]Glyph, count)
+	for idx := range glyphs {
+		pixels := make([][]byte, height)
+		for y := range pixels {
+			offset := (idx*height + y) * rowSize
+			pixels[y] = unpackBits(data[offset:offset+rowSize], width)
+		}
+		glyphs[idx] = Glyph{Pixels: pixels}
+	}
+	return glyphs
+}
+
+// psfIndexGlyphs maps each glyph to the rune matching its index, for fonts without a unicode
+// table.
+func psfIndexGlyphs(bitmaps []Glyph) map[rune]Glyph {
+	glyphs := make(map[rune]Glyph, len(bitmaps))
+	for idx, glyph := range bitmaps {
+		glyphs[rune(idx)] = glyph
+	}
+	return glyphs
+}
@@ -0,0 +1,125 @@
+package scrollphathd_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestLoadPSF(t *testing.T) {
+	// PSF2 font with a single 3x2 glyph, mapped to 'x' by the unicode table
+	var buf bytes.Buffer
+	buf.Write([]byte{0x72, 0xb5, 0x4a, 0x86})
+	for _, val := range []uint32{0, 32, 1, 1, 2, 2, 3} {
+		binary.Write(&buf, binary.LittleEndian, val)
+	}
+	buf.Write([]byte{0xa0, 0x40})
+	buf.Write([]byte{'x', 0xff})
+
+	font, err := scrollphathd.LoadPSF(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	glyph, ok := font.Glyph('x')
+	if !ok {
+		t.Fatal("expected glyph for 'x'")
+	}
+	expected := [][]byte{{255, 0, 255}, {0, 255, 0}}
+	for y, row := range expected {
+		for x, val := range row {
+			if glyph.Pixels[y][x] != val {
+				t.Fatalf("value at (%d, %d) was different (%d) than expected (%d)", x, y, glyph.Pixels[y][x], val)
+			}
+		}
+	}
+	if _, ok := font.Glyph(0); ok {
+		t.Fatal("expected glyphs to be mapped by the unicode table")
+	}
+}
+
+func TestLoadPSF1(t *testing.T) {
+	// PSF1 font with 256 8x2 glyphs, where glyph 0 is set and glyph 1 is a solid top row
+	psf1 := func(mode byte, table []uint16) *bytes.Buffer {
+		var buf bytes.Buffer
+		buf.Write([]byte{0x36, 0x04, mode, 2})
+		glyphs := make([]byte, 256*2)
+		copy(glyphs, []byte{0xa0, 0x40, 0xff, 0x00})
+		buf.Write(glyphs)
+		for _, val := range table {
+			binary.Write(&buf, binary.LittleEndian, val)
+		}
+		return &buf
+	}
+
+	font, err := scrollphathd.LoadPSF(psf1(0, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if font.Height() != 2 {
+		t.Fatalf("font height was %d, expected 2", font.Height())
+	}
+	glyph, ok := font.Glyph(0)
+	if !ok {
+		t.Fatal("expected glyphs to be mapped by index")
+	}
+	expected := [][]byte{{255, 0, 255, 0, 0, 0, 0, 0}, {0, 255, 0, 0, 0, 0, 0, 0}}
+	for y, row := range expected {
+		for x, val := range row {
+			if glyph.Pixels[y][x] != val {
+				t.Fatalf("value at (%d, %d) was different (%d) than expected (%d)", x, y, glyph.Pixels[y][x], val)
+			}
+		}
+	}
+	if _, ok := font.Glyph(255); !ok {
+		t.Fatal("expected glyph for index 255")
+	}
+
+	// With a unicode table, glyph 0 is 'x' followed by a sequence that should be ignored, and
+	// glyph 1 is both 'y' and 'z'
+	font, err = scrollphathd.LoadPSF(psf1(0x02, []uint16{'x', 0xfffe, 'a', 0x0301, 0xffff, 'y', 'z', 0xffff}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for r, idx := range map[rune]int{'x': 0, 'y': 1, 'z': 1} {
+		glyph, ok := font.Glyph(r)
+		if !ok {
+			t.Fatalf("expected glyph for %q", r)
+		}
+		// Only glyph 1 has the second pixel of its top row lit
+		if lit := glyph.Pixels[0][1] != 0; lit != (idx == 1) {
+			t.Fatalf("expected %q to map to glyph %d", r, idx)
+		}
+	}
+	for _, r := range []rune{0, 'a', 0x0301} {
+		if _, ok := font.Glyph(r); ok {
+			t.Fatalf("expected no glyph for %q", r)
+		}
+	}
+}
+
+func TestLoadPSF_Invalid(t *testing.T) {
+	testCases := []struct {
+		name   string
+		header []uint32
+	}{
+		{name: "zero glyph count", header: []uint32{0, 32, 0, 0, 1, 1, 1}},
+		{name: "zero glyph size", header: []uint32{0, 32, 0, 1, 0, 0, 0}},
+		{name: "mismatched glyph size", header: []uint32{0, 32, 0, 1, 0x7fffffff, 1, 1}},
+		{name: "huge glyphs", header: []uint32{0, 32, 0, 1, 1 << 20, 1 << 10, 1 << 13}},
+		{name: "too many glyphs", header: []uint32{0, 32, 0, 1 << 30, 1, 1, 1}},
+		{name: "huge header", header: []uint32{0, 0x7fffffff, 0, 1 << 16, 8192, 256, 256}},
+		{name: "glyphs past end of data", header: []uint32{0, 32, 0, 1 << 16, 8192, 256, 256}},
+	}
+	for _, tc := range testCases {
+		var buf bytes.Buffer
+		buf.Write([]byte{0x72, 0xb5, 0x4a, 0x86})
+		for _, val := range tc.header {
+			binary.Write(&buf, binary.LittleEndian, val)
+		}
+		if _, err := scrollphathd.LoadPSF(&buf); err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package scrollphathd
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Run runs a render loop at the given frame rate, until the context is cancelled. For each
+// frame, update is called with the frame number and the time since the previous frame, then the
+// display is shown.
+// If update and Show take longer than a frame, the loop skips ahead to stay in time rather than
+// falling further behind. Skipped frames are not rendered, but are still counted in the frame
+// number, and can be observed with WithDroppedFrameHandler.
+// Once the context is cancelled, the device is cleared or halted according to the stop action,
+// and the context's error is returned. If showing a frame fails, the loop stops and the error is
+// returned.
+func (d *Display) Run(ctx context.Context, fps int, update func(frame int, dt time.Duration), opts ...RunOption) error {
+	if fps <= 0 || time.Second/time.Duration(fps) == 0 {
+		return fmt.Errorf("received invalid frame rate %d", fps)
+	}
+	options := defaultRunOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	interval := time.Second / time.Duration(fps)
+	start := time.Now()
+	last := start
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for frame := 0; ; {
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		if ctx.Err() != nil {
+			if err := d.stop(options.stopAction); err != nil {
+				return err
+			}
+			return ctx.Err()
+		}
+
+		now := time.Now()
+		update(frame, now.Sub(last))
+		last = now
+		if err := d.Show(); err != nil {
+			return err
+		}
+
+		// Skip any frames whose time has already passed entirely
+		frame++
+		late := time.Since(start.Add(time.Duration(frame) * interval))
+		if dropped := int(late / interval); dropped > 0 {
+			frame += dropped
+			if options.droppedFrameHandler != nil {
+				options.droppedFrameHandler(dropped)
+			}
+		}
+		timer.Reset(time.Until(start.Add(time.Duration(frame) * interval)))
+	}
+}
+
+// stop applies the given stop action to the device.
+func (d *Display) stop(action StopAction) error {
+	switch action {
+	case StopClear:
+		return d.Clear()
+	case StopHalt:
+		halter, ok := d.device.(interface {
+			Halt() error
+		})
+		if !ok {
+			return d.Clear()
+		}
+		return d.handleError(d.halt(halter))
+	}
+	return nil
+}
+
+// halt halts the device while holding the frame mutex.
+func (d *Display) halt(halter interface {
+	Halt() error
+}) error {
+	d.frame.Lock()
+	defer d.frame.Unlock()
+	return halter.Halt()
+}
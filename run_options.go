@@ -0,0 +1,42 @@
+package scrollphathd
+
+// RunOption allows specifying behavior for Display.Run.
+type RunOption func(*runOptions)
+
+// StopAction specifies what Display.Run does to the device when its context is cancelled.
+type StopAction int
+
+const (
+	// StopClear clears the display.
+	StopClear StopAction = iota
+	// StopHalt halts the device, if it supports halting, such as Driver. Otherwise the display
+	// is cleared.
+	StopHalt
+	// StopNone leaves the last frame on the display.
+	StopNone
+)
+
+// WithStopAction specifies what happens to the device when the render loop is stopped (default
+// StopClear).
+func WithStopAction(action StopAction) RunOption {
+	return func(options *runOptions) {
+		options.stopAction = action
+	}
+}
+
+// WithDroppedFrameHandler specifies a function that is called whenever the render loop falls
+// behind and has to skip frames, with the number of frames that were skipped.
+func WithDroppedFrameHandler(handler func(dropped int)) RunOption {
+	return func(options *runOptions) {
+		options.droppedFrameHandler = handler
+	}
+}
+
+type runOptions struct {
+	stopAction          StopAction
+	droppedFrameHandler func(dropped int)
+}
+
+var defaultRunOptions = runOptions{
+	stopAction: StopClear,
+}
@@ -0,0 +1,57 @@
+package scrollphathd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_Run(t *testing.T) {
+	dev, disp := getDisplay()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var frames []int
+	dropped := 0
+	err := disp.Run(ctx, 100, func(frame int, dt time.Duration) {
+		frames = append(frames, frame)
+		disp.SetPixel(frame%3, 0, 255)
+		switch len(frames) {
+		case 1:
+			// Fall behind, so that some frames are skipped
+			time.Sleep(35 * time.Millisecond)
+		case 3:
+			cancel()
+		}
+	}, scrollphathd.WithDroppedFrameHandler(func(n int) {
+		dropped += n
+	}))
+	if err != context.Canceled {
+		t.Fatalf("Run returned %v, expected context.Canceled", err)
+	}
+
+	if len(frames) != 3 || frames[0] != 0 || frames[1] < 2 {
+		t.Fatalf("unexpected frame numbers %v", frames)
+	}
+	if dropped != frames[1]-1 {
+		t.Fatalf("%d frames were dropped, expected %d", dropped, frames[1]-1)
+	}
+
+	// The display should be cleared on stop
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 0},
+		{0, 0, 0},
+	})
+}
+
+func TestDisplay_RunInvalid(t *testing.T) {
+	_, disp := getDisplay()
+	for _, fps := range []int{0, -1, 2e9} {
+		if err := disp.Run(context.Background(), fps, func(int, time.Duration) {}); err == nil {
+			t.Errorf("expected error for frame rate %d", fps)
+		}
+	}
+}
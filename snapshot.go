@@ -0,0 +1,103 @@
+package scrollphathd
+
+import "image"
+
+// Snapshot is a copy of the state of a Display, as returned by Display.Snapshot. The saved
+// pixels of the selected layer can be read with Bounds and Pixel, and the whole state can be
+// returned to with Display.Restore.
+type Snapshot struct {
+	// Layers are restored in place, so that existing references to them remain valid
+	layers   []*Layer
+	states   []Layer
+	selected *Layer
+}
+
+// Bounds returns the area covered by the selected layer's buffer when the snapshot was taken,
+// in the same coordinates as Layer.Bounds.
+func (s *Snapshot) Bounds() image.Rectangle {
+	if state := s.selectedState(); state != nil {
+		return state.bounds()
+	}
+	return image.Rectangle{}
+}
+
+// Pixel returns the value at the given coordinate of the selected layer's buffer when the
+// snapshot was taken. The coordinate is not mapped through any transform, and pixels outside of
+// the buffer are 0.
+func (s *Snapshot) Pixel(x, y int) byte {
+	if state := s.selectedState(); state != nil {
+		return state.pixel(x, y)
+	}
+	return 0
+}
+
+// selectedState returns the saved state of the selected layer.
+func (s *Snapshot) selectedState() *Layer {
+	for i, layer := range s.layers {
+		if layer == s.selected {
+			return &s.states[i]
+		}
+	}
+	return nil
+}
+
+// GetPixel returns the value at the given coordinate of the selected layer's buffer, mapped
+// through the current transform. Pixels outside of the buffer are 0. Sprites are not included.
+func (d *Display) GetPixel(x, y int) byte {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.getPixel(x, y)
+}
+
+// Snapshot returns a copy of the display's layers, including their buffers, scroll offsets,
+// flipping and other settings, and which layer is selected. The snapshot can be restored later
+// with Restore, for example to return to the previous screen after temporarily showing an
+// alert.
+func (d *Display) Snapshot() *Snapshot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	s := &Snapshot{
+		layers:   append([]*Layer(nil), d.layers...),
+		states:   make([]Layer, len(d.layers)),
+		selected: d.layer,
+	}
+	for i, layer := range d.layers {
+		s.states[i] = layer.clone()
+	}
+	return s
+}
+
+// Restore returns the display's layers to the state saved in the given snapshot. Layers that
+// were added since the snapshot was taken are removed, and layers that were removed are added
+// back. Sprites are returned to the layers they belonged to, although their positions are not
+// restored. The same snapshot can be restored any number of times.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) Restore(s *Snapshot) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, layer := range d.layers {
+		for _, sprite := range layer.sprites {
+			sprite.setLayer(nil)
+		}
+	}
+
+	d.layers = append([]*Layer(nil), s.layers...)
+	for i, layer := range d.layers {
+		*layer = s.states[i].clone()
+		for _, sprite := range layer.sprites {
+			sprite.setLayer(layer)
+		}
+	}
+	d.layer = s.selected
+}
+
+// clone returns a copy of the layer with its own buffer and sprite list.
+func (l *Layer) clone() Layer {
+	c := *l
+	c.buffer = make([][]byte, len(l.buffer))
+	for y, row := range l.buffer {
+		c.buffer[y] = append([]byte(nil), row...)
+	}
+	c.sprites = append([]*Sprite(nil), l.sprites...)
+	return c
+}
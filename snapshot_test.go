@@ -0,0 +1,56 @@
+package scrollphathd_test
+
+import (
+	"image"
+	"testing"
+)
+
+func TestDisplay_Snapshot(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.SetPixel(0, 0, 10)
+	disp.SetPixel(4, 1, 20)
+	disp.ScrollTo(2, 0)
+	snapshot := disp.Snapshot()
+	if bounds := snapshot.Bounds(); bounds != image.Rect(0, 0, 5, 3) {
+		t.Fatalf("snapshot bounds were %v, expected (0,0)-(5,3)", bounds)
+	}
+
+	// Show an alert over the top of a modified screen
+	disp.SetPixel(2, 0, 30)
+	disp.ScrollTo(0, 0)
+	if _, err := disp.AddLayer("alert", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := disp.SelectLayer("alert"); err != nil {
+		t.Fatal(err)
+	}
+	disp.Fill(0, 2, 3, 1, 255)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{10, 0, 30},
+		{0, 0, 0},
+		{255, 255, 255},
+	})
+
+	// The snapshot still holds the pixels from when it was taken
+	if val := snapshot.Pixel(4, 1); val != 20 {
+		t.Fatalf("snapshot value at (4, 1) was %d, expected 20", val)
+	}
+	if val := snapshot.Pixel(2, 0); val != 0 {
+		t.Fatalf("snapshot value at (2, 0) was %d, expected 0", val)
+	}
+
+	disp.Restore(snapshot)
+	if disp.Layer("alert") != nil {
+		t.Fatal("expected alert layer to be removed")
+	}
+	if val := disp.GetPixel(2, 0); val != 0 {
+		t.Fatalf("value at (2, 0) was %d, expected 0", val)
+	}
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 20},
+		{0, 0, 0},
+	})
+}
@@ -0,0 +1,186 @@
+package scrollphathd
+
+import "math"
+
+// NewSparkline returns a sparkline that renders a time series into the given region of the
+// display. Values are added with Push, and older values scroll off to the left.
+// The sparkline always draws into the layer that is selected when it is created, and the region
+// is in that layer's buffer coordinates - later changes to the selected layer, transform or clip
+// rectangle do not affect it.
+func NewSparkline(display *Display, x, y, width, height int, opts ...SparklineOption) *Sparkline {
+	if width < 1 || height < 1 {
+		panic("sparkline dimensions must be 1 or greater")
+	}
+	options := defaultSparklineOptions
+	options.capacity = width
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	display.mu.Lock()
+	layer := display.layer
+	display.mu.Unlock()
+
+	return &Sparkline{
+		options: options,
+		display: display,
+		layer:   layer,
+		x:       x,
+		y:       y,
+		width:   width,
+		height:  height,
+		values:  make([]float64, options.capacity),
+	}
+}
+
+// Sparkline is a widget that renders a scrolling time series into a region of a Display. The
+// most recent values are kept in a ring buffer, with the newest value drawn in the rightmost
+// column.
+type Sparkline struct {
+	options             sparklineOptions
+	display             *Display
+	layer               *Layer
+	x, y, width, height int
+
+	// The remaining state is guarded by the display's mutex, since it is only ever changed
+	// while drawing
+
+	// Ring buffer of values - start is the index of the oldest value
+	values       []float64
+	start, count int
+
+	// Scale that was used for the last render, to determine whether an incremental update
+	// is possible
+	drawn     bool
+	low, high float64
+}
+
+// Push adds a new value to the sparkline and updates the display buffer. If the scale is
+// unchanged, the existing columns are shifted left and only the new column is drawn. Otherwise
+// the whole sparkline is redrawn, as it is when the capacity is smaller than the width - the
+// shifted columns would still hold values that have been evicted from the ring buffer.
+// Results must be explicitly pushed to the device with Show.
+func (s *Sparkline) Push(val float64) {
+	s.display.mu.Lock()
+	defer s.display.mu.Unlock()
+	defer s.target()()
+	if s.count < len(s.values) {
+		s.values[(s.start+s.count)%len(s.values)] = val
+		s.count++
+	} else {
+		s.values[s.start] = val
+		s.start = (s.start + 1) % len(s.values)
+	}
+
+	low, high := s.scale()
+	if !s.drawn || low != s.low || high != s.high || len(s.values) < s.width {
+		s.draw()
+		return
+	}
+
+	d := s.display
+	for col := 0; col < s.width-1; col++ {
+		for row := 0; row < s.height; row++ {
+			d.putPixel(s.x+col, s.y+row, d.getPixel(s.x+col+1, s.y+row))
+		}
+	}
+	s.drawColumn(s.width-1, s.count-1)
+}
+
+// Values returns the retained values, from oldest to newest.
+func (s *Sparkline) Values() []float64 {
+	s.display.mu.Lock()
+	defer s.display.mu.Unlock()
+	values := make([]float64, s.count)
+	for i := range values {
+		values[i] = s.value(i)
+	}
+	return values
+}
+
+// Draw renders the whole sparkline into the display buffer.
+// Results must be explicitly pushed to the device with Show.
+func (s *Sparkline) Draw() {
+	s.display.mu.Lock()
+	defer s.display.mu.Unlock()
+	defer s.target()()
+	s.draw()
+}
+
+// target switches drawing to the sparkline's layer, without any transform or clip rectangle. It
+// returns a function that restores the previous layer and drawing state.
+func (s *Sparkline) target() func() {
+	d := s.display
+	layer, state := d.layer, d.state
+	d.layer, d.state = s.layer, identityState
+	return func() {
+		d.layer, d.state = layer, state
+	}
+}
+
+func (s *Sparkline) draw() {
+	s.low, s.high = s.scale()
+	s.drawn = true
+	for col := 0; col < s.width; col++ {
+		s.drawColumn(col, s.count-s.width+col)
+	}
+}
+
+// drawColumn clears the given column, and renders the value at the given index into it. Indexes
+// outside of the retained values leave the column blank.
+func (s *Sparkline) drawColumn(col, idx int) {
+	d := s.display
+	x := s.x + col
+	d.clearRect(x, s.y, 1, s.height)
+	if idx < 0 || idx >= s.count {
+		return
+	}
+
+	row := s.row(s.value(idx))
+	from, to := row, row
+	switch {
+	case s.options.style == SparklineArea:
+		to = s.height - 1
+	case idx > 0:
+		// Connect the line to the previous value
+		prev := s.row(s.value(idx - 1))
+		if prev < from {
+			from = prev + 1
+		} else if prev > to {
+			to = prev - 1
+		}
+	}
+	for y := from; y <= to; y++ {
+		d.setPixel(x, s.y+y, s.options.brightness)
+	}
+}
+
+// row maps the given value to a row of the sparkline, where 0 is the top row.
+func (s *Sparkline) row(val float64) int {
+	level := 0.0
+	if span := s.high - s.low; span > 0 {
+		level = math.Min(math.Max((val-s.low)/span, 0), 1)
+	}
+	return s.height - 1 - int(level*float64(s.height-1)+0.5)
+}
+
+// value returns the value at the given index, counting from the oldest retained value.
+func (s *Sparkline) value(idx int) float64 {
+	return s.values[(s.start+idx)%len(s.values)]
+}
+
+// scale returns the range of values that the sparkline should currently map to its height.
+func (s *Sparkline) scale() (float64, float64) {
+	if !s.options.autoRange {
+		return s.options.low, s.options.high
+	}
+	low, high := math.Inf(1), math.Inf(-1)
+	for i := s.count - s.width; i < s.count; i++ {
+		if i < 0 {
+			continue
+		}
+		low = math.Min(low, s.value(i))
+		high = math.Max(high, s.value(i))
+	}
+	return low, high
+}
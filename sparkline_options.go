@@ -0,0 +1,63 @@
+package scrollphathd
+
+// SparklineOption allows specifying behavior for a Sparkline.
+type SparklineOption func(*sparklineOptions)
+
+// SparklineStyle specifies how a Sparkline renders its values.
+type SparklineStyle int
+
+const (
+	// SparklineLine renders values as a connected line.
+	SparklineLine SparklineStyle = iota
+	// SparklineArea renders values as a filled area below the line.
+	SparklineArea
+)
+
+// WithSparklineCapacity specifies the number of values that are retained (default is the
+// width of the sparkline). Only as many values as fit in the sparkline are displayed.
+func WithSparklineCapacity(capacity int) SparklineOption {
+	return func(options *sparklineOptions) {
+		if capacity < 1 {
+			panic("sparkline capacity must be 1 or greater")
+		}
+		options.capacity = capacity
+	}
+}
+
+// WithSparklineRange specifies fixed values that map to the bottom and top rows of the
+// sparkline. Values outside of the range are clamped. By default, the sparkline scales
+// automatically to the minimum and maximum of the displayed values.
+func WithSparklineRange(low, high float64) SparklineOption {
+	return func(options *sparklineOptions) {
+		options.low, options.high = low, high
+		options.autoRange = false
+	}
+}
+
+// WithSparklineStyle specifies how values are rendered (default SparklineLine).
+func WithSparklineStyle(style SparklineStyle) SparklineOption {
+	return func(options *sparklineOptions) {
+		options.style = style
+	}
+}
+
+// WithSparklineBrightness specifies the brightness of lit pixels (default 255).
+func WithSparklineBrightness(brightness byte) SparklineOption {
+	return func(options *sparklineOptions) {
+		options.brightness = brightness
+	}
+}
+
+type sparklineOptions struct {
+	capacity   int
+	autoRange  bool
+	low, high  float64
+	style      SparklineStyle
+	brightness byte
+}
+
+var defaultSparklineOptions = sparklineOptions{
+	autoRange:  true,
+	style:      SparklineLine,
+	brightness: 255,
+}
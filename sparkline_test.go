@@ -0,0 +1,109 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestSparkline(t *testing.T) {
+	dev, disp := getDisplay()
+	spark := scrollphathd.NewSparkline(disp, 0, 0, 3, 3, scrollphathd.WithSparklineRange(0, 2), scrollphathd.WithSparklineBrightness(5))
+	spark.Push(0)
+	spark.Push(2)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 5},
+		{0, 0, 5},
+		{0, 5, 0},
+	})
+
+	// Older values shift left as new ones arrive
+	spark.Push(1)
+	spark.Push(1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{5, 0, 0},
+		{5, 5, 5},
+		{0, 0, 0},
+	})
+
+	if values := spark.Values(); len(values) != 3 || values[0] != 2 {
+		t.Fatalf("unexpected retained values %v", values)
+	}
+}
+
+func TestSparkline_SmallCapacity(t *testing.T) {
+	_, disp := getDisplay()
+	spark := scrollphathd.NewSparkline(disp, 0, 0, 6, 3, scrollphathd.WithSparklineCapacity(3), scrollphathd.WithSparklineRange(0, 5))
+	for i := 1; i <= 5; i++ {
+		spark.Push(float64(i))
+	}
+	if values := spark.Values(); len(values) != 3 || values[0] != 3 {
+		t.Fatalf("unexpected retained values %v", values)
+	}
+
+	// Pushing incrementally should render the same as a full redraw, without the evicted values
+	pushed := disp.Snapshot()
+	spark.Draw()
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 6; x++ {
+			if pushed.Pixel(x, y) != disp.GetPixel(x, y) {
+				t.Fatalf("value at (%d, %d) was %d after Push, but %d after Draw", x, y, pushed.Pixel(x, y), disp.GetPixel(x, y))
+			}
+		}
+	}
+	for y := 0; y < 3; y++ {
+		if val := disp.GetPixel(2, y); val != 0 {
+			t.Fatalf("value at (2, %d) was %d, expected evicted column to be blank", y, val)
+		}
+	}
+}
+
+func TestSparkline_Area(t *testing.T) {
+	dev, disp := getDisplay()
+	spark := scrollphathd.NewSparkline(disp, 0, 0, 3, 3, scrollphathd.WithSparklineStyle(scrollphathd.SparklineArea))
+	spark.Push(10)
+	spark.Push(20)
+	spark.Push(15)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 255, 0},
+		{0, 255, 255},
+		{255, 255, 255},
+	})
+}
+
+func TestSparkline_Layer(t *testing.T) {
+	dev, disp := getDisplay()
+	spark := scrollphathd.NewSparkline(disp, 0, 0, 3, 3, scrollphathd.WithSparklineRange(0, 2), scrollphathd.WithSparklineBrightness(5))
+	spark.Push(0)
+	spark.Push(2)
+
+	// Selecting another layer and transforming the display doesn't move the sparkline
+	if _, err := disp.AddLayer("top", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := disp.SelectLayer("top"); err != nil {
+		t.Fatal(err)
+	}
+	disp.Translate(1, 1)
+	disp.Mirror(true, false)
+	spark.Push(1)
+	spark.Push(1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{5, 0, 0},
+		{5, 5, 5},
+		{0, 0, 0},
+	})
+
+	// The transform is still in effect for other drawing
+	disp.SetPixel(0, 1, 9)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{5, 0, 0},
+		{5, 5, 5},
+		{0, 9, 0},
+	})
+}
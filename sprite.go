@@ -0,0 +1,259 @@
+package scrollphathd
+
+import (
+	"image"
+	"math"
+	"sync"
+)
+
+// NewSprite returns a sprite with the given bitmap. The mask specifies which pixels of the
+// sprite are opaque - if nil, all lit pixels are opaque and unlit pixels are transparent.
+// Note that the arrays should be indexed in row, col order. Every row of the bitmap must have the
+// same width, and the mask must have the same dimensions as the bitmap.
+func NewSprite(pixels [][]byte, mask [][]bool) *Sprite {
+	s := &Sprite{}
+	s.SetBitmap(pixels, mask)
+	return s
+}
+
+// Sprite is a bitmap that can be positioned and moved over a layer without modifying the layer's
+// buffer. Sprites are composited over their layer on Show, in the layer's buffer coordinates, so
+// they scroll and flip along with it. Parts of a sprite outside of the layer's buffer are not
+// shown.
+type Sprite struct {
+	// Sprites are drawn on Show while they may be moved from other goroutines, so their state
+	// is guarded by their own mutex. The display's mutex must be acquired first if both are
+	// needed.
+	mu sync.Mutex
+
+	pixels [][]byte
+	mask   [][]bool
+	x, y   float64
+	vx, vy float64
+	hidden bool
+	layer  *Layer
+}
+
+// AddSprite adds the sprite to the selected layer. Sprites added later are drawn on top. A
+// sprite can only belong to one layer at a time, so it is removed from any previous layer.
+func (d *Display) AddSprite(s *Sprite) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.layer != nil {
+		s.layer.removeSprite(s)
+	}
+	s.layer = d.layer
+	d.layer.sprites = append(d.layer.sprites, s)
+}
+
+// RemoveSprite removes the sprite from its layer.
+func (d *Display) RemoveSprite(s *Sprite) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.layer != nil {
+		s.layer.removeSprite(s)
+		s.layer = nil
+	}
+}
+
+// MoveSprites moves every sprite on the display by its velocity. This is typically called once
+// per frame, before Show.
+func (d *Display) MoveSprites() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, layer := range d.layers {
+		for _, s := range layer.sprites {
+			s.Move()
+		}
+	}
+}
+
+func (l *Layer) removeSprite(s *Sprite) {
+	for i, sprite := range l.sprites {
+		if sprite == s {
+			l.sprites = append(l.sprites[:i], l.sprites[i+1:]...)
+			return
+		}
+	}
+}
+
+// setLayer changes the layer that the sprite belongs to, without updating the layers' sprite
+// lists.
+func (s *Sprite) setLayer(l *Layer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.layer = l
+}
+
+// SetBitmap replaces the sprite's bitmap and mask, for example to animate it. See NewSprite.
+func (s *Sprite) SetBitmap(pixels [][]byte, mask [][]bool) {
+	for _, row := range pixels {
+		if len(row) != len(pixels[0]) {
+			panic("sprite bitmap rows must all be the same width")
+		}
+	}
+	if mask == nil {
+		mask = make([][]bool, len(pixels))
+		for y, row := range pixels {
+			mask[y] = make([]bool, len(row))
+			for x, val := range row {
+				mask[y][x] = val > 0
+			}
+		}
+	}
+	if len(mask) != len(pixels) {
+		panic("sprite mask must match the bitmap dimensions")
+	}
+	for y, row := range pixels {
+		if len(mask[y]) != len(row) {
+			panic("sprite mask must match the bitmap dimensions")
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pixels = pixels
+	s.mask = mask
+}
+
+// SetPosition moves the top left corner of the sprite to the given coordinate. Fractional
+// positions are rounded down when the sprite is drawn.
+func (s *Sprite) SetPosition(x, y float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.x, s.y = x, y
+}
+
+// Position returns the coordinate of the top left corner of the sprite.
+func (s *Sprite) Position() (float64, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.x, s.y
+}
+
+// SetVelocity configures how far the sprite moves each time Move is called. Velocities can be
+// fractional, for sprites that move slower than one pixel per frame.
+func (s *Sprite) SetVelocity(vx, vy float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vx, s.vy = vx, vy
+}
+
+// Velocity returns the velocity of the sprite.
+func (s *Sprite) Velocity() (float64, float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.vx, s.vy
+}
+
+// Move moves the sprite by its velocity.
+func (s *Sprite) Move() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.x += s.vx
+	s.y += s.vy
+}
+
+// SetVisible configures whether the sprite is drawn. Hidden sprites never collide.
+func (s *Sprite) SetVisible(visible bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hidden = !visible
+}
+
+// Bounds returns the pixels currently covered by the sprite.
+func (s *Sprite) Bounds() image.Rectangle {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.bounds()
+}
+
+func (s *Sprite) bounds() image.Rectangle {
+	x, y := int(math.Floor(s.x)), int(math.Floor(s.y))
+	width := 0
+	if len(s.pixels) > 0 {
+		width = len(s.pixels[0])
+	}
+	return image.Rect(x, y, x+width, y+len(s.pixels))
+}
+
+// Collides returns whether any opaque pixels of the two sprites overlap.
+func (s *Sprite) Collides(other *Sprite) bool {
+	// Each sprite is locked separately, so that concurrent calls in either order can't deadlock
+	a, b := s.shape(), other.shape()
+	if a.hidden || b.hidden {
+		return false
+	}
+	overlap := a.bounds.Intersect(b.bounds)
+	for y := overlap.Min.Y; y < overlap.Max.Y; y++ {
+		for x := overlap.Min.X; x < overlap.Max.X; x++ {
+			if a.opaque(x, y) && b.opaque(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CollidesWithBuffer returns whether any opaque pixels of the sprite overlap lit pixels in the
+// buffer of the sprite's layer. Sprites that haven't been added to a display never collide.
+func (s *Sprite) CollidesWithBuffer() bool {
+	s.mu.Lock()
+	shape, l := s.shapeLocked(), s.layer
+	s.mu.Unlock()
+	if shape.hidden || l == nil {
+		return false
+	}
+
+	l.display.mu.Lock()
+	defer l.display.mu.Unlock()
+	overlap := shape.bounds.Intersect(l.bounds())
+	for y := overlap.Min.Y; y < overlap.Max.Y; y++ {
+		for x := overlap.Min.X; x < overlap.Max.X; x++ {
+			if shape.opaque(x, y) && l.pixel(x, y) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// spriteShape is a copy of the parts of a sprite's state that determine which pixels it covers.
+type spriteShape struct {
+	pixels [][]byte
+	mask   [][]bool
+	bounds image.Rectangle
+	hidden bool
+}
+
+func (s *Sprite) shape() spriteShape {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.shapeLocked()
+}
+
+// shapeLocked is like shape, for when the sprite's mutex is already held.
+func (s *Sprite) shapeLocked() spriteShape {
+	return spriteShape{pixels: s.pixels, mask: s.mask, bounds: s.bounds(), hidden: s.hidden}
+}
+
+// opaque returns whether the sprite has an opaque pixel at the given layer coordinate.
+func (sh spriteShape) opaque(x, y int) bool {
+	if !(image.Point{X: x, Y: y}).In(sh.bounds) {
+		return false
+	}
+	return sh.mask[y-sh.bounds.Min.Y][x-sh.bounds.Min.X]
+}
+
+// pixelAt returns the value of the sprite at the given layer coordinate, and whether it is
+// opaque there.
+func (s *Sprite) pixelAt(x, y int) (byte, bool) {
+	shape := s.shape()
+	if shape.hidden || !shape.opaque(x, y) {
+		return 0, false
+	}
+	return shape.pixels[y-shape.bounds.Min.Y][x-shape.bounds.Min.X], true
+}
@@ -0,0 +1,67 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestSprite(t *testing.T) {
+	dev, disp := getDisplay()
+	disp.SetPixel(2, 2, 9)
+
+	// Unlit pixels are opaque through the mask
+	sprite := scrollphathd.NewSprite([][]byte{{5, 0}}, [][]bool{{true, true}})
+	disp.AddSprite(sprite)
+	sprite.SetVelocity(0.5, 1)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{5, 0, 0},
+		{0, 0, 0},
+		{0, 0, 9},
+	})
+
+	// Sub-pixel velocity only moves the sprite a whole pixel every other tick
+	disp.MoveSprites()
+	disp.MoveSprites()
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 0},
+		{0, 5, 0},
+	})
+	if !sprite.CollidesWithBuffer() {
+		t.Fatal("expected sprite to collide with buffer")
+	}
+
+	// The buffer is untouched once the sprite is removed
+	disp.RemoveSprite(sprite)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 0},
+		{0, 0, 9},
+	})
+}
+
+func TestSprite_Collides(t *testing.T) {
+	a := scrollphathd.NewSprite([][]byte{{1, 0}, {0, 1}}, nil)
+	b := scrollphathd.NewSprite([][]byte{{1}}, nil)
+	b.SetPosition(1, 0)
+	if a.Collides(b) {
+		t.Fatal("expected transparent pixels not to collide")
+	}
+	b.SetPosition(1.9, 1.2)
+	if !a.Collides(b) {
+		t.Fatal("expected opaque pixels to collide")
+	}
+}
+
+func TestSprite_RaggedBitmap(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for ragged bitmap")
+		}
+	}()
+	scrollphathd.NewSprite([][]byte{{1, 1}, {1}}, nil)
+}
@@ -0,0 +1,135 @@
+package scrollphathd
+
+import (
+	"image"
+	"unicode"
+)
+
+// SetFont configures the font used for rendering text.
+func (d *Display) SetFont(font Font) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.font = font
+}
+
+// WriteString renders the given string into the buffer using the display's font (Font5x7
+// unless configured otherwise), with the top left corner of the text at the given coordinate.
+// Lit pixels are set to the given brightness. The buffer grows as needed to fit the entire
+// string, so long strings can be scrolled across the display with Scroll or ScrollTo.
+// The string is laid out according to the given options. Runes that are not included in the
+// font are rendered using the fallback rune ('?' by default).
+// Returns the rendered width of the string in pixels.
+// Results must be explicitly pushed to the device with Show.
+func (d *Display) WriteString(x, y int, s string, brightness byte, opts ...TextOption) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	options := d.textOptions(opts)
+	glyphs, width := layoutText(s, options)
+
+	// Grow the buffer to the full extent of the text up front, rather than a glyph at a time.
+	// This also makes sure it is covered even if the trailing characters are blank
+	d.growRect(x, y, width, options.font.Height())
+	for _, placed := range glyphs {
+		d.drawGlyph(x+placed.x, y, placed.glyph, brightness)
+	}
+	return width
+}
+
+// MeasureString returns the bounding box that the given string would occupy if it were
+// rendered at the origin with WriteString using the same options.
+func (d *Display) MeasureString(s string, opts ...TextOption) image.Rectangle {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	options := d.textOptions(opts)
+	_, width := layoutText(s, options)
+	if width == 0 {
+		return image.Rectangle{}
+	}
+	return image.Rect(0, 0, width, options.font.Height())
+}
+
+func (d *Display) textOptions(opts []TextOption) textOptions {
+	options := defaultTextOptions
+	options.font = d.font
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// placedGlyph is a glyph positioned horizontally within a line of text.
+type placedGlyph struct {
+	x     int
+	glyph Glyph
+}
+
+// layoutText positions each rune of the string, returning the glyphs and the total width.
+func layoutText(s string, options textOptions) ([]placedGlyph, int) {
+	var glyphs []placedGlyph
+	pen := 0
+	prev, first := rune(0), true
+	for _, r := range s {
+		glyph, ok := options.font.Glyph(r)
+		if !ok {
+			r = options.fallback
+			if glyph, ok = options.font.Glyph(r); !ok {
+				continue
+			}
+		}
+
+		if !first {
+			pen += options.letterSpacing + options.kerning[KerningPair{Left: prev, Right: r}]
+		}
+		advance := options.font.Advance(r)
+		if options.proportional {
+			glyph, advance = trimGlyph(glyph, advance)
+		}
+		if unicode.IsSpace(r) {
+			advance += options.wordSpacing
+		}
+
+		glyphs = append(glyphs, placedGlyph{x: pen, glyph: glyph})
+		pen += advance
+		prev, first = r, false
+	}
+	return glyphs, pen
+}
+
+// trimGlyph removes blank columns from either side of the glyph, so that it advances by the
+// width of its lit pixels. Blank glyphs such as spaces are left alone.
+func trimGlyph(glyph Glyph, advance int) (Glyph, int) {
+	left, right := glyph.Width(), -1
+	for _, row := range glyph.Pixels {
+		for x, coverage := range row {
+			if coverage > 0 {
+				if x < left {
+					left = x
+				}
+				if x > right {
+					right = x
+				}
+			}
+		}
+	}
+	if right < 0 {
+		return glyph, advance
+	}
+
+	trimmed := glyph
+	trimmed.X = -left
+	trimmed.Advance = 0
+	return trimmed, right - left + 1
+}
+
+// drawGlyph renders the lit pixels of the glyph at the given pen position.
+func (d *Display) drawGlyph(x, y int, glyph Glyph, brightness byte) {
+	x += glyph.X
+	y += glyph.Y
+	for gy, row := range glyph.Pixels {
+		for gx, coverage := range row {
+			if coverage > 0 {
+				d.plotCoverage(x+gx, y+gy, brightness, coverage)
+			}
+		}
+	}
+}
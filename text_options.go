@@ -0,0 +1,70 @@
+package scrollphathd
+
+// TextOption allows specifying layout behavior when rendering text.
+type TextOption func(*textOptions)
+
+// KerningPair identifies two adjacent runes, for adjusting the spacing between them.
+type KerningPair struct {
+	Left, Right rune
+}
+
+// WithTextFont overrides the display's font for a single call.
+func WithTextFont(font Font) TextOption {
+	return func(options *textOptions) {
+		options.font = font
+	}
+}
+
+// WithLetterSpacing specifies the number of blank columns between characters (default 1).
+func WithLetterSpacing(spacing int) TextOption {
+	return func(options *textOptions) {
+		options.letterSpacing = spacing
+	}
+}
+
+// WithWordSpacing specifies the number of extra blank columns added to whitespace characters
+// (default 0).
+func WithWordSpacing(spacing int) TextOption {
+	return func(options *textOptions) {
+		options.wordSpacing = spacing
+	}
+}
+
+// WithKerning specifies adjustments to the spacing between pairs of runes. Negative values
+// move the runes closer together.
+func WithKerning(pairs map[KerningPair]int) TextOption {
+	return func(options *textOptions) {
+		options.kerning = pairs
+	}
+}
+
+// WithProportional specifies whether blank columns should be trimmed from either side of
+// each glyph, so that narrow characters take up less space (default false).
+func WithProportional(proportional bool) TextOption {
+	return func(options *textOptions) {
+		options.proportional = proportional
+	}
+}
+
+// WithFallbackRune specifies the rune that is rendered in place of runes that the font doesn't
+// include (default '?'). If the font doesn't include the fallback rune either, missing runes
+// are skipped.
+func WithFallbackRune(fallback rune) TextOption {
+	return func(options *textOptions) {
+		options.fallback = fallback
+	}
+}
+
+type textOptions struct {
+	font          Font
+	letterSpacing int
+	wordSpacing   int
+	kerning       map[KerningPair]int
+	proportional  bool
+	fallback      rune
+}
+
+var defaultTextOptions = textOptions{
+	letterSpacing: 1,
+	fallback:      '?',
+}
@@ -0,0 +1,203 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_WriteString(t *testing.T) {
+	dev, disp := getDisplay()
+	width := disp.WriteString(0, 0, "T.", 1)
+	if width != 11 {
+		t.Fatalf("rendered width was %d, expected %d", width, 11)
+	}
+
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{1, 1, 1},
+		{0, 0, 1},
+		{0, 0, 1},
+	})
+
+	// The period is at the bottom of the second character
+	disp.ScrollTo(7, 4)
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{1, 1, 0},
+		{1, 1, 0},
+	})
+}
+
+func TestDisplay_WriteStringFont(t *testing.T) {
+	dev, disp := getDisplay(scrollphathd.WithFont(scrollphathd.Font3x5))
+	width := disp.WriteString(0, 0, "ab", 1)
+	if width != 7 {
+		t.Fatalf("rendered width was %d, expected %d", width, 7)
+	}
+
+	// Lowercase falls back to uppercase in the tiny font
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 1, 0},
+		{1, 0, 1},
+		{1, 1, 1},
+	})
+
+	disp.SetFont(scrollphathd.Font6x7Bold)
+	width = disp.WriteString(0, 0, "ab", 1)
+	if width != 13 {
+		t.Fatalf("rendered width was %d, expected %d", width, 13)
+	}
+}
+
+func TestFont_Smoothed(t *testing.T) {
+	glyph, ok := scrollphathd.Font5x7Smoothed.Glyph('/')
+	if !ok {
+		t.Fatal("expected glyph for '/'")
+	}
+	// The inner corners of the diagonal are partially lit, while the stroke stays fully lit
+	if glyph.Pixels[4][1] != 255 || glyph.Pixels[4][0] < 0x80 || glyph.Pixels[4][0] == 255 {
+		t.Fatalf("unexpected smoothing for '/': %v", glyph.Pixels)
+	}
+
+	// Generated smoothing lights the same corners
+	glyph, _ = scrollphathd.Font5x7.Smoothed().Glyph('/')
+	if glyph.Pixels[4][1] != 255 || glyph.Pixels[4][0] < 0x80 || glyph.Pixels[4][0] == 255 {
+		t.Fatalf("unexpected smoothing for '/': %v", glyph.Pixels)
+	}
+}
+
+func TestFont_Bold(t *testing.T) {
+	glyph, ok := scrollphathd.Font6x7Bold.Glyph('m')
+	if !ok {
+		t.Fatal("expected glyph for 'm'")
+	}
+	if glyph.Width() != 6 || glyph.Height() != 7 {
+		t.Fatalf("glyph size was %dx%d, expected 6x7", glyph.Width(), glyph.Height())
+	}
+	// The gaps between the strokes stay open, unlike a glyph that has just been thickened
+	if glyph.Pixels[4][1] != 255 || glyph.Pixels[4][2] != 0 || glyph.Pixels[4][4] != 0 {
+		t.Fatalf("unexpected bold 'm': %v", glyph.Pixels)
+	}
+
+	// Generated bold glyphs are overlaid with a copy of themselves, shifted right
+	font := scrollphathd.NewBitmapFont(map[rune]scrollphathd.Glyph{
+		'a': {
+			Pixels: [][]byte{
+				{255, 0},
+				{0, 128},
+			},
+			Advance: 3,
+		},
+	}, 2, 2).Bold()
+	glyph, ok = font.Glyph('a')
+	if !ok {
+		t.Fatal("expected glyph for 'a'")
+	}
+	if font.Advance('a') != 4 {
+		t.Fatalf("advance was %d, expected 4", font.Advance('a'))
+	}
+	expected := [][]byte{
+		{255, 255, 0},
+		{0, 128, 128},
+	}
+	if glyph.Height() != len(expected) || glyph.Width() != len(expected[0]) {
+		t.Fatalf("glyph size was %dx%d, expected 3x2", glyph.Width(), glyph.Height())
+	}
+	for y, row := range expected {
+		for x, val := range row {
+			if glyph.Pixels[y][x] != val {
+				t.Fatalf("value at (%d, %d) was different (%d) than expected (%d)", x, y, glyph.Pixels[y][x], val)
+			}
+		}
+	}
+}
+
+func TestFont_Downsampled(t *testing.T) {
+	font := scrollphathd.NewBitmapFont(map[rune]scrollphathd.Glyph{
+		'a': {
+			Pixels: [][]byte{
+				{255, 255, 255},
+				{0, 255, 0},
+				{255, 0, 0},
+			},
+			X:       1,
+			Y:       -1,
+			Advance: 5,
+		},
+	}, 7, 6).Downsampled(2)
+
+	if font.Height() != 4 || font.Baseline() != 3 {
+		t.Fatalf("font height and baseline were %d and %d, expected 4 and 3", font.Height(), font.Baseline())
+	}
+	glyph, ok := font.Glyph('a')
+	if !ok {
+		t.Fatal("expected glyph for 'a'")
+	}
+	// Blocks are aligned to the pen position rather than the bitmap, so the offsets round
+	// outwards and the bitmap starts partway through the first block in each direction
+	if glyph.X != 0 || glyph.Y != -1 || glyph.Advance != 3 {
+		t.Fatalf("unexpected glyph offset (%d, %d) and advance %d", glyph.X, glyph.Y, glyph.Advance)
+	}
+	expected := [][]byte{
+		{63, 127},
+		{63, 63},
+	}
+	if glyph.Height() != len(expected) || glyph.Width() != len(expected[0]) {
+		t.Fatalf("glyph size was %dx%d, expected 2x2", glyph.Width(), glyph.Height())
+	}
+	for y, row := range expected {
+		for x, val := range row {
+			if glyph.Pixels[y][x] != val {
+				t.Fatalf("value at (%d, %d) was different (%d) than expected (%d)", x, y, glyph.Pixels[y][x], val)
+			}
+		}
+	}
+}
+
+func TestDisplay_MeasureString(t *testing.T) {
+	_, disp := getDisplay()
+	testCases := []struct {
+		name     string
+		s        string
+		opts     []scrollphathd.TextOption
+		expected int
+	}{
+		{name: "monospace", s: "il", expected: 11},
+		{name: "proportional", s: "il", opts: []scrollphathd.TextOption{scrollphathd.WithProportional(true)}, expected: 7},
+		{name: "letter spacing", s: "il", opts: []scrollphathd.TextOption{scrollphathd.WithLetterSpacing(3)}, expected: 13},
+		{name: "word spacing", s: "i l", opts: []scrollphathd.TextOption{scrollphathd.WithWordSpacing(2)}, expected: 19},
+		{
+			name:     "kerning",
+			s:        "AV",
+			opts:     []scrollphathd.TextOption{scrollphathd.WithKerning(map[scrollphathd.KerningPair]int{{Left: 'A', Right: 'V'}: -1})},
+			expected: 10,
+		},
+		{name: "unicode fallback", s: "é", expected: 5},
+		{name: "missing fallback", s: "é", opts: []scrollphathd.TextOption{scrollphathd.WithFallbackRune('é')}, expected: 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bounds := disp.MeasureString(tc.s, tc.opts...)
+			if bounds.Dx() != tc.expected {
+				t.Fatalf("measured width was %d, expected %d", bounds.Dx(), tc.expected)
+			}
+		})
+	}
+}
+
+func TestDisplay_WriteStringProportional(t *testing.T) {
+	dev, disp := getDisplay()
+	width := disp.WriteString(0, 2, "!!", 1, scrollphathd.WithProportional(true))
+	if width != 3 {
+		t.Fatalf("rendered width was %d, expected %d", width, 3)
+	}
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 0, 0},
+		{0, 0, 0},
+		{1, 0, 1},
+	})
+}
@@ -0,0 +1,135 @@
+package scrollphathd
+
+import (
+	"image"
+)
+
+// drawState is the transform and clip rectangle applied to drawing operations. Coordinates
+// passed to drawing operations are local coordinates, which are mapped into buffer coordinates
+// by the transform:
+//
+//	bufX = xx*x + xy*y + x0
+//	bufY = yx*x + yy*y + y0
+//
+// Only translation, 90 degree rotation and mirroring are supported, so the matrix entries are
+// always -1, 0 or 1.
+type drawState struct {
+	xx, xy, yx, yy int
+	x0, y0         int
+
+	// clip is in buffer coordinates, and is only applied if clipped is set
+	clip    image.Rectangle
+	clipped bool
+}
+
+var identityState = drawState{xx: 1, yy: 1}
+
+// Push saves the current drawing transform and clip rectangle, so that they can be restored
+// later with Pop. This allows reusable widgets to set up their own local coordinates without
+// affecting the caller.
+func (d *Display) Push() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stateStack = append(d.stateStack, d.state)
+}
+
+// Pop restores the drawing transform and clip rectangle saved by the most recent call to Push.
+// If there is no saved state, the transform and clip rectangle are reset.
+func (d *Display) Pop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.stateStack) == 0 {
+		d.state = identityState
+		return
+	}
+	d.state = d.stateStack[len(d.stateStack)-1]
+	d.stateStack = d.stateStack[:len(d.stateStack)-1]
+}
+
+// Translate moves the origin of subsequent drawing operations by the given offset, in the
+// current local coordinates.
+func (d *Display) Translate(dx, dy int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.state.x0, d.state.y0 = d.state.transform(dx, dy)
+}
+
+// Rotate rotates subsequent drawing operations clockwise around the current origin by the
+// given amount.
+func (d *Display) Rotate(rotation Rotation) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch rotation {
+	case Rotation0:
+	case Rotation90:
+		d.state.apply(0, -1, 1, 0)
+	case Rotation180:
+		d.state.apply(-1, 0, 0, -1)
+	case Rotation270:
+		d.state.apply(0, 1, -1, 0)
+	default:
+		panic("unknown rotation")
+	}
+}
+
+// Mirror mirrors subsequent drawing operations around the current origin. Mirroring along X
+// reverses the direction of the X axis, and likewise for Y.
+func (d *Display) Mirror(mirrorX, mirrorY bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	sx, sy := 1, 1
+	if mirrorX {
+		sx = -1
+	}
+	if mirrorY {
+		sy = -1
+	}
+	d.state.apply(sx, 0, 0, sy)
+}
+
+// Clip restricts subsequent drawing operations to the given rectangle, in the current local
+// coordinates. The rectangle is intersected with any existing clip rectangle, so a clip can
+// only be widened again with Pop.
+func (d *Display) Clip(x, y, width, height int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	clip := d.state.bufferRect(x, y, width, height)
+	if d.state.clipped {
+		clip = clip.Intersect(d.state.clip)
+	}
+	d.state.clip = clip
+	d.state.clipped = true
+}
+
+// transform maps the given local coordinate to buffer coordinates.
+func (s *drawState) transform(x, y int) (int, int) {
+	return s.xx*x + s.xy*y + s.x0, s.yx*x + s.yy*y + s.y0
+}
+
+// bufferRect maps the given local rectangle to buffer coordinates.
+func (s *drawState) bufferRect(x, y, width, height int) image.Rectangle {
+	if width <= 0 || height <= 0 {
+		return image.Rectangle{}
+	}
+	x1, y1 := s.transform(x, y)
+	x2, y2 := s.transform(x+width-1, y+height-1)
+	return image.Rect(minInt(x1, x2), minInt(y1, y2), maxInt(x1, x2)+1, maxInt(y1, y2)+1)
+}
+
+// apply combines the given linear map with the existing transform. The map is applied to
+// local coordinates before the existing transform.
+func (s *drawState) apply(xx, xy, yx, yy int) {
+	s.xx, s.xy, s.yx, s.yy =
+		s.xx*xx+s.xy*yx, s.xx*xy+s.xy*yy,
+		s.yx*xx+s.yy*yx, s.yx*xy+s.yy*yy
+}
+
+// toBuffer maps the given local coordinate to buffer coordinates. ok is false if the
+// coordinate falls outside of the clip rectangle.
+func (d *Display) toBuffer(x, y int) (bufX, bufY int, ok bool) {
+	bufX, bufY = d.state.transform(x, y)
+	if d.state.clipped && !(image.Point{X: bufX, Y: bufY}).In(d.state.clip) {
+		return bufX, bufY, false
+	}
+	return bufX, bufY, true
+}
@@ -0,0 +1,41 @@
+package scrollphathd_test
+
+import (
+	"testing"
+
+	"github.com/tomnz/scroll-phat-hd-go"
+)
+
+func TestDisplay_Transform(t *testing.T) {
+	dev, disp := getDisplay()
+
+	// Drawing is clipped to the local rectangle
+	disp.Push()
+	disp.Translate(1, 1)
+	disp.Clip(0, 0, 2, 2)
+	disp.Fill(0, 0, 5, 5, 10)
+	disp.Pop()
+
+	// Rotated 90 degrees clockwise around (2, 0)
+	disp.Push()
+	disp.Translate(2, 0)
+	disp.Rotate(scrollphathd.Rotation90)
+	disp.SetPixel(0, 0, 1)
+	disp.SetPixel(1, 0, 2)
+	disp.SetPixel(0, 1, 3)
+	disp.Pop()
+
+	// Mirrored around (2, 2)
+	disp.Push()
+	disp.Translate(2, 2)
+	disp.Mirror(true, false)
+	disp.SetPixel(2, 0, 4)
+	disp.Pop()
+
+	disp.Show()
+	dev.checkPixels(t, [][]byte{
+		{0, 3, 1},
+		{0, 10, 2},
+		{4, 10, 10},
+	})
+}